@@ -0,0 +1,85 @@
+package helpers
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"os/exec"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/crypto/scrypt"
+)
+
+// CommandResource is the schema of a `command` block whose stdout supplies a sensitive value.
+func CommandResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path to the executable.",
+			},
+			"args": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Arguments to pass to the command.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"env": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Environment variables to set for the command.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// RunCommand runs the command described by a CommandResource block and returns its stdout.
+func RunCommand(ctx context.Context, cmdMap map[string]interface{}) (string, error) {
+	path := cmdMap["path"].(string)
+
+	var args []string
+	if rawArgs, ok := cmdMap["args"].([]interface{}); ok {
+		for _, a := range rawArgs {
+			args = append(args, a.(string))
+		}
+	}
+
+	cmd := exec.CommandContext(ctx, path, args...)
+
+	if rawEnv, ok := cmdMap["env"].(map[string]interface{}); ok {
+		for k, v := range rawEnv {
+			cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", k, v.(string)))
+		}
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("command %q failed: %w\nstdout: %s\nstderr: %s", path, err, stdout.String(), stderr.String())
+	}
+
+	return stdout.String(), nil
+}
+
+// HashSensitiveValue hashes a secret using scrypt so the plaintext is not stored in Terraform state.
+// See rationale for scrypt choice:
+// https://github.com/yandex-cloud/terraform-provider-yandex/blob/master/yandex/resource_yandex_lockbox_secret_version_hashed.go#L121-L128
+func HashSensitiveValue(v interface{}) string {
+	value := v.(string)
+	if value == "" {
+		return ""
+	}
+	salt := []byte("|82&pvyYC[el3Z([,En#1:£!VJ2fKz")
+	hash, err := scrypt.Key([]byte(value), salt, 32768, 8, 1, 128)
+	if err != nil {
+		log.Printf("[ERROR] could not hash secret value: %v", err)
+		return ""
+	}
+	return base64.StdEncoding.EncodeToString(hash)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -51,7 +52,10 @@ func ParseYDBDatabaseEndpoint(endpoint string) (baseEP, databasePath string, use
 	return parts[2], dbSplit[1], useTLS, nil
 }
 
+// EscapeYQLString escapes s for a single-quoted YQL string literal. Backslashes are escaped
+// first, so a trailing or quote-preceding backslash cannot end the literal early.
 func EscapeYQLString(s string) string {
+	s = strings.ReplaceAll(s, "\\", "\\\\")
 	return strings.ReplaceAll(s, "'", "\\'")
 }
 
@@ -111,7 +115,10 @@ func TrimPath(path string) string {
 	return strings.Trim(path, "/")
 }
 
-func GetToken(ctx context.Context, creds auth.YdbCredentials, conn *grpc.ClientConn) (string, error) {
+func GetToken(ctx context.Context, creds auth.YdbCredentials, conn grpc.ClientConnInterface) (string, error) {
+	if creds.Credentials != nil {
+		return creds.Credentials.Token(ctx)
+	}
 	if creds.User != "" {
 		token, err := auth.GetTokenFromStaticCreds(ctx, creds.User, creds.Password, conn)
 		if err != nil {
@@ -235,3 +242,24 @@ func NormalizeYQLColumnTypeState(v interface{}) string {
 func SuppressYQLColumnTypeCaseDiff(_, old, newVal string, _ *schema.ResourceData) bool {
 	return strings.EqualFold(strings.TrimSpace(old), strings.TrimSpace(newVal))
 }
+
+// ValidatePositiveDuration is a schema.SchemaValidateFunc for attributes holding a positive Go
+// duration, e.g. "10s".
+func ValidatePositiveDuration(i interface{}, k string) ([]string, []error) {
+	d, err := time.ParseDuration(i.(string))
+	if err != nil {
+		return nil, []error{fmt.Errorf("%q must be a valid Go duration (e.g. \"10s\"): %w", k, err)}
+	}
+	if d <= 0 {
+		return nil, []error{fmt.Errorf("%q must be greater than zero", k)}
+	}
+	return nil, nil
+}
+
+// SuppressDurationDiff suppresses plan diffs between spellings of the same duration, e.g. "60s"
+// and "1m0s".
+func SuppressDurationDiff(_, oldValue, newValue string, _ *schema.ResourceData) bool {
+	oldD, err1 := time.ParseDuration(oldValue)
+	newD, err2 := time.ParseDuration(newValue)
+	return err1 == nil && err2 == nil && oldD == newD
+}
@@ -278,3 +278,25 @@ func TestNormalizeYQLColumnType(t *testing.T) {
 	assert.True(t, SuppressYQLColumnTypeCaseDiff("", "Int32", "int32", nil))
 	assert.False(t, SuppressYQLColumnTypeCaseDiff("", "Int32", "utf8", nil))
 }
+
+func TestPrepareYQLSettingsClauses(t *testing.T) {
+	settings := []YQLSetting{
+		YQLIntSetting("CONCURRENT_QUERY_LIMIT", 10),
+		YQLResetSetting("QUEUE_SIZE"),
+		YQLFloatSetting("TOTAL_CPU_LIMIT_PERCENT_PER_NODE", 12.5),
+		YQLStringSetting("MEMBER_NAME", "o'brien"),
+		YQLResetSetting("RANK"),
+	}
+
+	assert.Equal(t, " WITH (CONCURRENT_QUERY_LIMIT = 10, TOTAL_CPU_LIMIT_PERCENT_PER_NODE = 12.5, MEMBER_NAME = 'o\\'brien')", PrepareYQLWithClause(settings))
+	assert.Equal(t, "SET (CONCURRENT_QUERY_LIMIT = 10, TOTAL_CPU_LIMIT_PERCENT_PER_NODE = 12.5, MEMBER_NAME = 'o\\'brien'), RESET (QUEUE_SIZE, RANK)", PrepareYQLSetResetClause(settings))
+	assert.Equal(t, "RESET (QUEUE_SIZE)", PrepareYQLSetResetClause([]YQLSetting{YQLResetSetting("QUEUE_SIZE")}))
+	assert.Equal(t, "", PrepareYQLWithClause([]YQLSetting{YQLResetSetting("QUEUE_SIZE")}))
+}
+
+func TestEscapeYQLString(t *testing.T) {
+	assert.Equal(t, `it\'s`, EscapeYQLString(`it's`))
+	assert.Equal(t, `a\\\'b`, EscapeYQLString(`a\'b`))
+	assert.Equal(t, `a\\`, EscapeYQLString(`a\`))
+	assert.Equal(t, `'a\\'`, YQLStringSetting("password", `a\`).Value)
+}
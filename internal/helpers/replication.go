@@ -0,0 +1,103 @@
+package helpers
+
+import (
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Issue"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/retry"
+)
+
+// ReplicationSourceResource is the schema of a `source` block describing the connection to the
+// remote database of an async replication or transfer.
+func ReplicationSourceResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"connection_string": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Connection string of the source database, e.g. `grpcs://host:2135/?database=/Root/db`.",
+			},
+			"token_secret_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Name of the secret in the local database holding a token for the source database.",
+				ConflictsWith: []string{"source.0.user", "source.0.password_secret_name"},
+			},
+			"user": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "User of the source database.",
+				RequiredWith:  []string{"source.0.password_secret_name"},
+				ConflictsWith: []string{"source.0.token_secret_name"},
+			},
+			"password_secret_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Name of the secret in the local database holding the password of `user`.",
+				RequiredWith:  []string{"source.0.user"},
+				ConflictsWith: []string{"source.0.token_secret_name"},
+			},
+		},
+	}
+}
+
+// ReplicationSource is a ReplicationSourceResource block.
+type ReplicationSource struct {
+	ConnectionString   string
+	TokenSecretName    string
+	User               string
+	PasswordSecretName string
+}
+
+// ExpandReplicationSource reads the `source` block, ok is false if it is not set.
+func ExpandReplicationSource(d *schema.ResourceData) (src ReplicationSource, ok bool) {
+	raw := d.Get("source").([]interface{})
+	if len(raw) == 0 || raw[0] == nil {
+		return ReplicationSource{}, false
+	}
+	m := raw[0].(map[string]interface{})
+	return ReplicationSource{
+		ConnectionString:   m["connection_string"].(string),
+		TokenSecretName:    m["token_secret_name"].(string),
+		User:               m["user"].(string),
+		PasswordSecretName: m["password_secret_name"].(string),
+	}, true
+}
+
+// CredentialSettings returns the configured credentials as WITH/SET settings.
+func (s ReplicationSource) CredentialSettings() []YQLSetting {
+	var res []YQLSetting
+	if s.TokenSecretName != "" {
+		res = append(res, YQLStringSetting("TOKEN_SECRET_NAME", s.TokenSecretName))
+	}
+	if s.User != "" {
+		res = append(res, YQLStringSetting("USER", s.User))
+	}
+	if s.PasswordSecretName != "" {
+		res = append(res, YQLStringSetting("PASSWORD_SECRET_NAME", s.PasswordSecretName))
+	}
+	return res
+}
+
+// IssueMessages flattens nested YDB issues into their messages.
+func IssueMessages(issues []*Ydb_Issue.IssueMessage) []string {
+	var res []string
+	for _, issue := range issues {
+		if issue.GetMessage() != "" {
+			res = append(res, issue.GetMessage())
+		}
+		res = append(res, IssueMessages(issue.GetIssues())...)
+	}
+	return res
+}
+
+// IsSchemeErrorStatus reports whether a raw gRPC describe call failed because the path does not
+// exist.
+func IsSchemeErrorStatus(err error) bool {
+	var statusErr *retry.StatusError
+	return errors.As(err, &statusErr) && statusErr.Status == Ydb.StatusIds_SCHEME_ERROR
+}
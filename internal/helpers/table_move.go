@@ -0,0 +1,16 @@
+package helpers
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+// PlanTableMove plans the location attributes of a table child, such as an index or a
+// changefeed, whose table has changed: table_path and table_id describe the same table, so
+// when one of them changes the other one is recomputed by the move.
+func PlanTableMove(d *schema.ResourceDiff) error {
+	switch {
+	case d.HasChange("table_id"):
+		return d.SetNewComputed("table_path")
+	case d.HasChange("table_path"):
+		return d.SetNewComputed("table_id")
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanTableMove(t *testing.T) {
+	res := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"table_path": {Type: schema.TypeString, Optional: true, Computed: true},
+			"table_id":   {Type: schema.TypeString, Optional: true, Computed: true},
+			"name":       {Type: schema.TypeString, Required: true, ForceNew: true},
+		},
+		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+			return PlanTableMove(d)
+		},
+	}
+	state := &terraform.InstanceState{
+		ID: "grpc://localhost:2136/?database=/local?path=t/idx",
+		Attributes: map[string]string{
+			"id":         "grpc://localhost:2136/?database=/local?path=t/idx",
+			"table_path": "t",
+			"table_id":   "grpc://localhost:2136/?database=/local?path=t",
+			"name":       "idx",
+		},
+	}
+
+	diff, err := res.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"table_id": "grpc://localhost:2136/?database=/local?path=dir/t",
+		"name":     "idx",
+	}), nil)
+	require.NoError(t, err)
+	assert.False(t, diff.RequiresNew())
+	assert.True(t, diff.Attributes["table_path"].NewComputed)
+
+	diff, err = res.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"table_path": "dir/t",
+		"name":       "idx",
+	}), nil)
+	require.NoError(t, err)
+	assert.False(t, diff.RequiresNew())
+	assert.True(t, diff.Attributes["table_id"].NewComputed)
+}
@@ -0,0 +1,75 @@
+package helpers
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// YQLSetting is a `NAME = value` pair of the WITH, SET and RESET clauses of YQL statements
+// (resource pools, replications, ...). Value is already formatted as a YQL literal, an empty
+// Value resets the setting to its default.
+type YQLSetting struct {
+	Name  string
+	Value string
+}
+
+// YQLIntSetting formats an integer setting.
+func YQLIntSetting(name string, v int) YQLSetting {
+	return YQLSetting{Name: name, Value: strconv.Itoa(v)}
+}
+
+// YQLFloatSetting formats a floating point setting without trailing zeroes.
+func YQLFloatSetting(name string, v float64) YQLSetting {
+	return YQLSetting{Name: name, Value: strconv.FormatFloat(v, 'f', -1, 64)}
+}
+
+// YQLStringSetting formats a string setting as a quoted literal.
+func YQLStringSetting(name string, v string) YQLSetting {
+	return YQLSetting{Name: name, Value: "'" + EscapeYQLString(v) + "'"}
+}
+
+// YQLResetSetting resets the setting to its default in PrepareYQLSetResetClause.
+func YQLResetSetting(name string) YQLSetting {
+	return YQLSetting{Name: name}
+}
+
+// PrepareYQLWithClause returns ` WITH (A = 1, B = 'x')`, or an empty string if there is nothing to
+// set. Reset settings are skipped: omitting a setting on creation keeps its default.
+func PrepareYQLWithClause(settings []YQLSetting) string {
+	set := joinYQLSettings(settings, true)
+	if set == "" {
+		return ""
+	}
+	return " WITH (" + set + ")"
+}
+
+// PrepareYQLSetResetClause returns `SET (A = 1), RESET (B)` for ALTER statements.
+func PrepareYQLSetResetClause(settings []YQLSetting) string {
+	var clauses []string
+	if set := joinYQLSettings(settings, true); set != "" {
+		clauses = append(clauses, "SET ("+set+")")
+	}
+	if reset := joinYQLSettings(settings, false); reset != "" {
+		clauses = append(clauses, "RESET ("+reset+")")
+	}
+	return strings.Join(clauses, ", ")
+}
+
+func joinYQLSettings(settings []YQLSetting, set bool) string {
+	parts := make([]string, 0, len(settings))
+	for _, s := range settings {
+		switch {
+		case set && s.Value != "":
+			parts = append(parts, s.Name+" = "+s.Value)
+		case !set && s.Value == "":
+			parts = append(parts, s.Name)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// YQLIntervalSetting formats a duration as an ISO 8601 Interval literal, e.g. Interval('PT1.5S').
+func YQLIntervalSetting(name string, d time.Duration) YQLSetting {
+	return YQLSetting{Name: name, Value: "Interval('PT" + strconv.FormatFloat(d.Seconds(), 'f', -1, 64) + "S')"}
+}
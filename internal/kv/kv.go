@@ -2,6 +2,7 @@ package kv
 
 import (
 	"context"
+	"crypto/tls"
 	"crypto/x509"
 
 	"github.com/ydb-platform/ydb-go-genproto/draft/Ydb_KeyValue_V1"
@@ -10,6 +11,7 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
 
+	"github.com/ydb-platform/terraform-provider-ydb/internal/pool"
 	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
 )
 
@@ -18,23 +20,67 @@ type ClientParams struct {
 	Database         string
 	UseTLS           bool
 	AuthCreds        auth.YdbCredentials
+	// TLSConfig replaces the system certificate pool setup for TLS connections when set.
+	TLSConfig *tls.Config
 }
 
-func CreateDBConnection(ctx context.Context, params ClientParams) (*grpc.ClientConn, error) {
+// Conn is a gRPC connection borrowed from the provider connection pool. Close returns it to the
+// pool instead of closing the underlying connection.
+type Conn struct {
+	*grpc.ClientConn
+	release func()
+}
+
+// Close releases the connection. It must be called exactly once.
+func (c *Conn) Close() error {
+	c.release()
+	return nil
+}
+
+type connKey struct {
+	databaseEndpoint string
+	useTLS           bool
+	tlsConfig        *tls.Config
+}
+
+// CreateDBConnection returns a connection to params.DatabaseEndpoint shared with other operations.
+func CreateDBConnection(ctx context.Context, params ClientParams) (*Conn, error) {
+	key := connKey{
+		databaseEndpoint: params.DatabaseEndpoint,
+		useTLS:           params.UseTLS,
+		tlsConfig:        params.TLSConfig,
+	}
+	conn, release, err := pool.Default.Acquire(ctx, key, func(context.Context) (interface{}, func() error, error) {
+		conn, err := dial(params)
+		if err != nil {
+			return nil, nil, err
+		}
+		return conn, conn.Close, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &Conn{ClientConn: conn.(*grpc.ClientConn), release: release}, nil
+}
+
+func dial(params ClientParams) (*grpc.ClientConn, error) {
 	var opts grpc.DialOption
 
-	if params.UseTLS {
+	switch {
+	case params.UseTLS && params.TLSConfig != nil:
+		opts = grpc.WithTransportCredentials(credentials.NewTLS(params.TLSConfig.Clone()))
+	case params.UseTLS:
 		pool, _ := x509.SystemCertPool()
 		creds := credentials.NewClientTLSFromCert(pool, "")
 		opts = grpc.WithTransportCredentials(creds)
-	} else {
+	default:
 		opts = grpc.WithTransportCredentials(insecure.NewCredentials())
 	}
 
 	return grpc.NewClient(params.DatabaseEndpoint, opts)
 }
 
-func AddMetaDataKvStub(ctx context.Context, metaParams ClientParams, conn *grpc.ClientConn) (context.Context, Ydb_KeyValue_V1.KeyValueServiceClient) {
+func AddMetaDataKvStub(ctx context.Context, metaParams ClientParams, conn grpc.ClientConnInterface) (context.Context, Ydb_KeyValue_V1.KeyValueServiceClient) {
 	m := metadata.New(map[string]string{
 		"x-ydb-database":    metaParams.Database,
 		"x-ydb-auth-ticket": metaParams.AuthCreds.Token,
@@ -0,0 +1,193 @@
+// Package pool shares YDB connections between resource operations of the provider process.
+//
+// Terraform calls CRUD functions of many resources in parallel, and each of them used to open and
+// close its own driver, paying for discovery and login every time. A Pool keeps one connection per
+// key (endpoint, database, credentials), creates it lazily on first use, tracks how many callers
+// hold it and closes it once it stays unused for the idle timeout or when the pool is closed.
+package pool
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// DefaultIdleTimeout is how long an unused connection stays open in Default.
+const DefaultIdleTimeout = 5 * time.Minute
+
+// DefaultOpenTimeout bounds opening a connection: discovery and login against an unreachable
+// endpoint would otherwise block every caller waiting for it.
+const DefaultOpenTimeout = time.Minute
+
+// ErrClosed is returned by Acquire after the pool has been closed.
+var ErrClosed = errors.New("connection pool is closed")
+
+// Default is the provider-wide pool used by the table, query, topic and KV clients.
+var Default = New(DefaultIdleTimeout)
+
+// OpenFunc creates a new connection and returns the function closing it.
+type OpenFunc func(ctx context.Context) (conn interface{}, closeConn func() error, err error)
+
+type entry struct {
+	ready     chan struct{}
+	conn      interface{}
+	closeConn func() error
+	err       error
+	refs      int
+	idle      *time.Timer
+}
+
+// Pool is a concurrency-safe set of reference-counted connections.
+type Pool struct {
+	idleTimeout time.Duration
+	openTimeout time.Duration
+
+	mu      sync.Mutex
+	entries map[interface{}]*entry
+	closed  bool
+}
+
+// New returns an empty pool closing connections that stay unused for idleTimeout.
+// A non-positive idleTimeout keeps unused connections open until Close.
+func New(idleTimeout time.Duration) *Pool {
+	return &Pool{
+		idleTimeout: idleTimeout,
+		openTimeout: DefaultOpenTimeout,
+		entries:     make(map[interface{}]*entry),
+	}
+}
+
+// Acquire returns the connection for key, opening it with open if there is none yet. Concurrent
+// callers with the same key wait for a single open, each of them until its own ctx is done. The
+// returned release function must be called exactly once when the caller is done with the
+// connection.
+func (p *Pool) Acquire(ctx context.Context, key interface{}, open OpenFunc) (interface{}, func(), error) {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return nil, nil, ErrClosed
+	}
+	e, ok := p.entries[key]
+	if !ok {
+		e = &entry{ready: make(chan struct{})}
+		p.entries[key] = e
+	}
+	e.refs++
+	if e.idle != nil {
+		e.idle.Stop()
+		e.idle = nil
+	}
+	p.mu.Unlock()
+
+	if !ok {
+		go p.open(ctx, key, e, open)
+	}
+	select {
+	case <-e.ready:
+	case <-ctx.Done():
+		p.release(key, e)
+		return nil, nil, ctx.Err()
+	}
+
+	if e.err != nil {
+		err := e.err
+		p.release(key, e)
+		return nil, nil, err
+	}
+
+	var once sync.Once
+	return e.conn, func() { once.Do(func() { p.release(key, e) }) }, nil
+}
+
+// open opens the connection of e. The connection outlives the operation that happened to open
+// it and is shared with the other callers, so the open is bounded by the open timeout instead of
+// the cancellation of ctx.
+func (p *Pool) open(ctx context.Context, key interface{}, e *entry, open OpenFunc) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), p.openTimeout)
+	defer cancel()
+	conn, closeConn, err := open(ctx)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	e.conn, e.closeConn, e.err = conn, closeConn, err
+	close(e.ready)
+	if e.refs == 0 {
+		// Every caller gave up while the connection was being opened.
+		p.unusedLocked(key, e)
+	}
+}
+
+func (p *Pool) release(key interface{}, e *entry) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	e.refs--
+	if e.refs > 0 {
+		return
+	}
+	select {
+	case <-e.ready:
+		p.unusedLocked(key, e)
+	default:
+		// The connection is still being opened, open handles it.
+	}
+}
+
+// unusedLocked drops a failed entry or schedules closing an unused connection. p.mu must be held.
+func (p *Pool) unusedLocked(key interface{}, e *entry) {
+	if e.err != nil {
+		// Failed opens are not cached, the next Acquire retries.
+		if p.entries[key] == e {
+			delete(p.entries, key)
+		}
+		return
+	}
+	if p.closed || p.idleTimeout <= 0 {
+		return
+	}
+	e.idle = time.AfterFunc(p.idleTimeout, func() {
+		p.mu.Lock()
+		if e.refs > 0 || p.entries[key] != e {
+			p.mu.Unlock()
+			return
+		}
+		delete(p.entries, key)
+		p.mu.Unlock()
+		_ = e.closeConn()
+	})
+}
+
+// Len returns the number of connections in the pool, including ones being opened.
+func (p *Pool) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.entries)
+}
+
+// Close closes all connections and makes further Acquire calls fail. It waits for connections
+// being opened, but does not wait for callers to release the ones they hold.
+func (p *Pool) Close() error {
+	p.mu.Lock()
+	p.closed = true
+	entries := p.entries
+	p.entries = make(map[interface{}]*entry)
+	p.mu.Unlock()
+
+	var errs []error
+	for _, e := range entries {
+		<-e.ready
+		p.mu.Lock()
+		if e.idle != nil {
+			e.idle.Stop()
+			e.idle = nil
+		}
+		p.mu.Unlock()
+		if e.err == nil && e.closeConn != nil {
+			if err := e.closeConn(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,185 @@
+package pool
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeConn struct {
+	closed atomic.Bool
+}
+
+type counter struct {
+	opens  atomic.Int32
+	closes atomic.Int32
+}
+
+func (c *counter) open(delay time.Duration, err error) OpenFunc {
+	return func(context.Context) (interface{}, func() error, error) {
+		c.opens.Add(1)
+		time.Sleep(delay)
+		if err != nil {
+			return nil, nil, err
+		}
+		conn := &fakeConn{}
+		return conn, func() error {
+			c.closes.Add(1)
+			conn.closed.Store(true)
+			return nil
+		}, nil
+	}
+}
+
+func TestAcquireSharesConnection(t *testing.T) {
+	p := New(0)
+	var c counter
+
+	const workers = 50
+	conns := make([]interface{}, workers)
+	releases := make([]func(), workers)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			conn, release, err := p.Acquire(context.Background(), "key", c.open(10*time.Millisecond, nil))
+			assert.NoError(t, err)
+			conns[i], releases[i] = conn, release
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), c.opens.Load())
+	for i := 1; i < workers; i++ {
+		assert.Same(t, conns[0], conns[i])
+	}
+	for _, release := range releases {
+		release()
+	}
+	assert.Equal(t, int32(0), c.closes.Load(), "unused connections stay open without idle timeout")
+	assert.Equal(t, 1, p.Len())
+
+	require.NoError(t, p.Close())
+	assert.Equal(t, int32(1), c.closes.Load())
+	assert.True(t, conns[0].(*fakeConn).closed.Load())
+}
+
+func TestAcquireDifferentKeys(t *testing.T) {
+	p := New(0)
+	var c counter
+
+	a, releaseA, err := p.Acquire(context.Background(), "a", c.open(0, nil))
+	require.NoError(t, err)
+	b, releaseB, err := p.Acquire(context.Background(), "b", c.open(0, nil))
+	require.NoError(t, err)
+	defer releaseA()
+	defer releaseB()
+
+	assert.NotSame(t, a, b)
+	assert.Equal(t, int32(2), c.opens.Load())
+}
+
+func TestIdleTimeout(t *testing.T) {
+	p := New(20 * time.Millisecond)
+	var c counter
+
+	_, release, err := p.Acquire(context.Background(), "key", c.open(0, nil))
+	require.NoError(t, err)
+	release()
+	release() // extra calls are no-ops
+
+	_, release, err = p.Acquire(context.Background(), "key", c.open(0, nil))
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), c.opens.Load(), "released connection is reused before the idle timeout")
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, int32(0), c.closes.Load(), "held connection is never closed by the idle timer")
+	release()
+
+	assert.Eventually(t, func() bool { return c.closes.Load() == 1 }, time.Second, 5*time.Millisecond)
+	assert.Equal(t, 0, p.Len())
+}
+
+func TestAcquireRetriesFailedOpen(t *testing.T) {
+	p := New(0)
+	var c counter
+	errOpen := errors.New("discovery failed")
+
+	_, _, err := p.Acquire(context.Background(), "key", c.open(0, errOpen))
+	require.ErrorIs(t, err, errOpen)
+	assert.Equal(t, 0, p.Len())
+
+	_, release, err := p.Acquire(context.Background(), "key", c.open(0, nil))
+	require.NoError(t, err)
+	release()
+	assert.Equal(t, int32(2), c.opens.Load())
+}
+
+func TestAcquireContextCanceledWhileWaiting(t *testing.T) {
+	p := New(0)
+	var c counter
+
+	started := make(chan struct{})
+	go func() {
+		_, release, err := p.Acquire(context.Background(), "key", func(ctx context.Context) (interface{}, func() error, error) {
+			close(started)
+			return c.open(50*time.Millisecond, nil)(ctx)
+		})
+		if assert.NoError(t, err) {
+			release()
+		}
+	}()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	_, _, err := p.Acquire(ctx, "key", c.open(0, nil))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestAcquireOpenTimeout(t *testing.T) {
+	p := New(0)
+	p.openTimeout = 10 * time.Millisecond
+
+	_, _, err := p.Acquire(context.Background(), "key", func(ctx context.Context) (interface{}, func() error, error) {
+		<-ctx.Done()
+		return nil, nil, ctx.Err()
+	})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 0, p.Len())
+}
+
+func TestAcquireContextCanceledWhileOpening(t *testing.T) {
+	p := New(0)
+	var c counter
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	_, _, err := p.Acquire(ctx, "key", c.open(50*time.Millisecond, nil))
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// The open goes on for the other callers and is not retried.
+	conn, release, err := p.Acquire(context.Background(), "key", c.open(0, nil))
+	require.NoError(t, err)
+	assert.NotNil(t, conn)
+	release()
+	assert.Equal(t, int32(1), c.opens.Load())
+	require.NoError(t, p.Close())
+	assert.Equal(t, int32(1), c.closes.Load())
+}
+
+func TestAcquireAfterClose(t *testing.T) {
+	p := New(0)
+	require.NoError(t, p.Close())
+
+	var c counter
+	_, _, err := p.Acquire(context.Background(), "key", c.open(0, nil))
+	assert.ErrorIs(t, err, ErrClosed)
+	assert.Equal(t, int32(0), c.opens.Load())
+}
@@ -0,0 +1,54 @@
+package asyncreplication
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func (h *handler) Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connectionString := d.Get("connection_string").(string)
+	replicationPath := d.Get("path").(string)
+
+	_, database, _, err := helpers.ParseYDBDatabaseEndpoint(connectionString)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	fullPath := helpers.JoinYDBCatalogPath(database, replicationPath)
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: connectionString,
+		AuthCreds:        h.authCreds,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: "failed to initialize DB connection", Detail: err.Error()},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	err = db.ExecQuery(ctx, prepareCreateReplicationQuery(fullPath, expandItems(d), createSettings(d)), tbl.NonIdempotent)
+	if err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: "failed to execute `CREATE ASYNC REPLICATION ...`", Detail: err.Error()},
+		}
+	}
+	d.SetId(connectionString + "?path=" + helpers.TrimPath(replicationPath))
+
+	if d.Get("state").(string) == StateDone {
+		err = db.ExecQuery(ctx, prepareAlterReplicationQuery(fullPath, failoverSettings()), tbl.Idempotent)
+		if err != nil {
+			return diag.Diagnostics{
+				{Severity: diag.Error, Summary: "failed to switch replication to DONE state", Detail: err.Error()},
+			}
+		}
+	}
+
+	return h.Read(ctx, d, meta)
+}
@@ -0,0 +1,99 @@
+package asyncreplication
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func (h *handler) Read(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	entity, err := helpers.ParseYDBEntityID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: entity.PrepareFullYDBEndpoint(),
+		AuthCreds:        h.authCreds,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: "failed to initialize table client", Detail: err.Error()},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	desc, err := describeReplication(ctx, db, entity.GetFullEntityPath())
+	if err != nil {
+		if helpers.IsSchemeErrorStatus(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to describe replication %q: %s", entity.GetFullEntityPath(), err)
+	}
+
+	_ = d.Set("connection_string", entity.PrepareFullYDBEndpoint())
+	_ = d.Set("path", entity.GetEntityPath())
+
+	params := desc.GetConnectionParams()
+	src := map[string]interface{}{
+		"connection_string":    params.GetConnectionString(),
+		"token_secret_name":    params.GetOauth().GetTokenSecretName(),
+		"user":                 params.GetStaticCredentials().GetUser(),
+		"password_secret_name": params.GetStaticCredentials().GetPasswordSecretName(),
+	}
+	sourceDatabase := params.GetDatabase()
+	if cfg := d.Get("source").([]interface{}); len(cfg) > 0 && cfg[0] != nil {
+		configured := cfg[0].(map[string]interface{})["connection_string"].(string)
+		// The connection string is stored as endpoint and database, keep the configured spelling.
+		if ep, database, _, err := helpers.ParseYDBDatabaseEndpoint(configured); err == nil &&
+			ep == params.GetEndpoint() && database == params.GetDatabase() {
+			src["connection_string"] = configured
+		}
+	}
+	if src["connection_string"] == "" {
+		src["connection_string"] = sourceConnectionString(params.GetEndpoint(), params.GetDatabase(), params.GetEnableSsl())
+	}
+	_ = d.Set("source", []interface{}{src})
+
+	_ = d.Set("item", itemsState(expandItems(d), desc.GetItems(), sourceDatabase, entity.GetDatabasePath()))
+
+	if global := desc.GetGlobalConsistency(); global != nil {
+		_ = d.Set("consistency_level", ConsistencyLevelGlobal)
+		interval := global.GetCommitInterval().AsDuration()
+		if configured, err := time.ParseDuration(d.Get("commit_interval").(string)); err != nil || configured != interval {
+			_ = d.Set("commit_interval", interval.String())
+		}
+	} else {
+		_ = d.Set("consistency_level", ConsistencyLevelRow)
+		_ = d.Set("commit_interval", "")
+	}
+
+	s := replicationStatus(desc)
+	if s.name == "done" {
+		_ = d.Set("state", StateDone)
+	} else {
+		_ = d.Set("state", StateRunning)
+	}
+	_ = d.Set("status", s.name)
+	_ = d.Set("lag", s.lag)
+	_ = d.Set("initial_scan_progress", s.initialScanProgress)
+	_ = d.Set("errors", s.errors)
+
+	return nil
+}
+
+func sourceConnectionString(endpoint, database string, useTLS bool) string {
+	scheme := "grpc://"
+	if useTLS {
+		scheme = "grpcs://"
+	}
+	return scheme + endpoint + "/?database=" + database
+}
@@ -0,0 +1,128 @@
+package asyncreplication
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ydb-platform/ydb-go-genproto/draft/Ydb_Replication_V1"
+	"github.com/ydb-platform/ydb-go-genproto/draft/protos/Ydb_Replication"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	ydb "github.com/ydb-platform/ydb-go-sdk/v3"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	"github.com/ydb-platform/terraform-provider-ydb/internal/retry"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+// Desired states of a replication. A replication switched to StateDone stops replicating and
+// makes the replicas writable, which is how a failover to the target database is done.
+const (
+	StateRunning = "RUNNING"
+	StateDone    = "DONE"
+)
+
+const (
+	ConsistencyLevelRow    = "Row"
+	ConsistencyLevelGlobal = "Global"
+)
+
+type item struct {
+	sourcePath string
+	targetPath string
+}
+
+func expandItems(d *schema.ResourceData) []item {
+	raw := d.Get("item").([]interface{})
+	res := make([]item, 0, len(raw))
+	for _, v := range raw {
+		m := v.(map[string]interface{})
+		res = append(res, item{sourcePath: m["source_path"].(string), targetPath: m["target_path"].(string)})
+	}
+	return res
+}
+
+func createSettings(d *schema.ResourceData) []helpers.YQLSetting {
+	src, _ := helpers.ExpandReplicationSource(d)
+	res := []helpers.YQLSetting{helpers.YQLStringSetting("CONNECTION_STRING", src.ConnectionString)}
+	res = append(res, src.CredentialSettings()...)
+	res = append(res, helpers.YQLStringSetting("CONSISTENCY_LEVEL", d.Get("consistency_level").(string)))
+	if v := d.Get("commit_interval").(string); v != "" {
+		interval, _ := time.ParseDuration(v)
+		res = append(res, helpers.YQLIntervalSetting("COMMIT_INTERVAL", interval))
+	}
+	return res
+}
+
+// describeReplication describes the replication at fullPath with item statistics. The SDK has no
+// replication client, so the request goes to the replication service directly.
+func describeReplication(ctx context.Context, db *tbl.Driver, fullPath string) (*Ydb_Replication.DescribeReplicationResult, error) {
+	client := Ydb_Replication_V1.NewReplicationServiceClient(ydb.GRPCConn(db.Driver))
+	result := &Ydb_Replication.DescribeReplicationResult{}
+	err := db.Retry(ctx, "describe replication", tbl.Idempotent, func(ctx context.Context) error {
+		resp, err := client.DescribeReplication(ctx, &Ydb_Replication.DescribeReplicationRequest{Path: fullPath, IncludeStats: true})
+		if err != nil {
+			return fmt.Errorf("describe_replication problem: %w", err)
+		}
+		op := resp.GetOperation()
+		if op.GetStatus() != Ydb.StatusIds_SUCCESS {
+			return fmt.Errorf("describe replication operation code not success: %w", &retry.StatusError{Status: op.GetStatus(), Issues: op.GetIssues()})
+		}
+		if err := op.GetResult().UnmarshalTo(result); err != nil {
+			return fmt.Errorf("unmarshal_to problem: %w", err)
+		}
+		return nil
+	})
+	return result, err
+}
+
+// status is the observed state of a replication.
+type status struct {
+	name                string
+	lag                 string
+	initialScanProgress float64
+	errors              []string
+}
+
+func replicationStatus(desc *Ydb_Replication.DescribeReplicationResult) status {
+	switch {
+	case desc.GetRunning() != nil:
+		s := status{name: "running"}
+		if stats := desc.GetRunning().GetStats(); stats != nil {
+			if stats.GetLag() != nil {
+				s.lag = stats.GetLag().AsDuration().String()
+			}
+			s.initialScanProgress = float64(stats.GetInitialScanProgress())
+		}
+		return s
+	case desc.GetError() != nil:
+		return status{name: "error", errors: helpers.IssueMessages(desc.GetError().GetIssues())}
+	case desc.GetDone() != nil:
+		return status{name: "done"}
+	case desc.GetPaused() != nil:
+		return status{name: "paused"}
+	}
+	return status{name: "unknown"}
+}
+
+// itemsState converts described items to the state, keeping the configured spelling of paths
+// that point to the same tables. Described paths are absolute.
+func itemsState(configured []item, described []*Ydb_Replication.DescribeReplicationResult_Item, sourceDatabase, targetDatabase string) []interface{} {
+	res := make([]interface{}, 0, len(described))
+	for _, di := range described {
+		v := item{
+			sourcePath: helpers.RelativizeYDBCatalogPath(sourceDatabase, di.GetSourcePath()),
+			targetPath: helpers.RelativizeYDBCatalogPath(targetDatabase, di.GetDestinationPath()),
+		}
+		for _, ci := range configured {
+			if helpers.JoinYDBCatalogPath(sourceDatabase, ci.sourcePath) == di.GetSourcePath() &&
+				helpers.JoinYDBCatalogPath(targetDatabase, ci.targetPath) == di.GetDestinationPath() {
+				v = ci
+				break
+			}
+		}
+		res = append(res, map[string]interface{}{"source_path": v.sourcePath, "target_path": v.targetPath})
+	}
+	return res
+}
@@ -0,0 +1,95 @@
+package asyncreplication
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ydb-platform/ydb-go-genproto/draft/protos/Ydb_Replication"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Issue"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+)
+
+func TestPrepareReplicationQueries(t *testing.T) {
+	src := helpers.ReplicationSource{ConnectionString: "grpcs://source:2135/?database=/Root/src", TokenSecretName: "replication_token"}
+	opts := append([]helpers.YQLSetting{helpers.YQLStringSetting("CONNECTION_STRING", src.ConnectionString)}, src.CredentialSettings()...)
+	opts = append(opts,
+		helpers.YQLStringSetting("CONSISTENCY_LEVEL", ConsistencyLevelGlobal),
+		helpers.YQLIntervalSetting("COMMIT_INTERVAL", 90*time.Second),
+	)
+
+	testData := []struct {
+		testName string
+		got      string
+		expected string
+	}{
+		{
+			testName: "create",
+			got: prepareCreateReplicationQuery("/Root/dst/replication", []item{
+				{sourcePath: "orders", targetPath: "replica/orders"},
+				{sourcePath: "/Root/src/users", targetPath: "replica/users"},
+			}, opts),
+			expected: "CREATE ASYNC REPLICATION `/Root/dst/replication` FOR `orders` AS `replica/orders`, `/Root/src/users` AS `replica/users` " +
+				"WITH (CONNECTION_STRING = 'grpcs://source:2135/?database=/Root/src', TOKEN_SECRET_NAME = 'replication_token', " +
+				"CONSISTENCY_LEVEL = 'Global', COMMIT_INTERVAL = Interval('PT90S'))",
+		},
+		{
+			testName: "alter credentials",
+			got:      prepareAlterReplicationQuery("/Root/dst/replication", helpers.ReplicationSource{User: "repl", PasswordSecretName: "repl_password"}.CredentialSettings()),
+			expected: "ALTER ASYNC REPLICATION `/Root/dst/replication` SET (USER = 'repl', PASSWORD_SECRET_NAME = 'repl_password')",
+		},
+		{
+			testName: "failover",
+			got:      prepareAlterReplicationQuery("/Root/dst/replication", failoverSettings()),
+			expected: "ALTER ASYNC REPLICATION `/Root/dst/replication` SET (STATE = 'DONE', FAILOVER_MODE = 'FORCE')",
+		},
+		{
+			testName: "drop",
+			got:      prepareDropReplicationQuery("/Root/dst/replication", false),
+			expected: "DROP ASYNC REPLICATION `/Root/dst/replication`",
+		},
+		{
+			testName: "drop cascade",
+			got:      prepareDropReplicationQuery("/Root/dst/replication", true),
+			expected: "DROP ASYNC REPLICATION `/Root/dst/replication` CASCADE",
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.testName, func(t *testing.T) {
+			assert.Equal(t, v.expected, v.got)
+		})
+	}
+}
+
+func TestItemsState(t *testing.T) {
+	configured := []item{{sourcePath: "/Root/src/orders", targetPath: "replica/orders"}}
+	described := []*Ydb_Replication.DescribeReplicationResult_Item{
+		{SourcePath: "/Root/src/orders", DestinationPath: "/Root/dst/replica/orders"},
+		{SourcePath: "/Root/src/users", DestinationPath: "/Root/dst/replica/users"},
+	}
+
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"source_path": "/Root/src/orders", "target_path": "replica/orders"},
+		map[string]interface{}{"source_path": "users", "target_path": "replica/users"},
+	}, itemsState(configured, described, "/Root/src", "/Root/dst"))
+}
+
+func TestReplicationStatus(t *testing.T) {
+	running := &Ydb_Replication.DescribeReplicationResult{State: &Ydb_Replication.DescribeReplicationResult_Running{
+		Running: &Ydb_Replication.DescribeReplicationResult_RunningState{
+			Stats: &Ydb_Replication.DescribeReplicationResult_Stats{Lag: durationpb.New(1500 * time.Millisecond), InitialScanProgress: proto.Float32(100)},
+		},
+	}}
+	assert.Equal(t, status{name: "running", lag: "1.5s", initialScanProgress: 100}, replicationStatus(running))
+
+	failed := &Ydb_Replication.DescribeReplicationResult{State: &Ydb_Replication.DescribeReplicationResult_Error{
+		Error: &Ydb_Replication.DescribeReplicationResult_ErrorState{Issues: []*Ydb_Issue.IssueMessage{
+			{Message: "source unavailable", Issues: []*Ydb_Issue.IssueMessage{{Message: "connection refused"}}},
+		}},
+	}}
+	assert.Equal(t, status{name: "error", errors: []string{"source unavailable", "connection refused"}}, replicationStatus(failed))
+}
@@ -0,0 +1,57 @@
+package asyncreplication
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func (h *handler) Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	entity, err := helpers.ParseYDBEntityID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	credentialsChanged := d.HasChanges("source.0.token_secret_name", "source.0.user", "source.0.password_secret_name")
+	failover := d.HasChange("state") && d.Get("state").(string) == StateDone
+	if !credentialsChanged && !failover {
+		return h.Read(ctx, d, meta)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: entity.PrepareFullYDBEndpoint(),
+		AuthCreds:        h.authCreds,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: "failed to initialize table client", Detail: err.Error()},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	if credentialsChanged {
+		src, _ := helpers.ExpandReplicationSource(d)
+		err = db.ExecQuery(ctx, prepareAlterReplicationQuery(entity.GetFullEntityPath(), src.CredentialSettings()), tbl.Idempotent)
+		if err != nil {
+			return diag.Diagnostics{
+				{Severity: diag.Error, Summary: "failed to execute query ALTER ASYNC REPLICATION ...", Detail: err.Error()},
+			}
+		}
+	}
+	if failover {
+		err = db.ExecQuery(ctx, prepareAlterReplicationQuery(entity.GetFullEntityPath(), failoverSettings()), tbl.Idempotent)
+		if err != nil {
+			return diag.Diagnostics{
+				{Severity: diag.Error, Summary: "failed to switch replication to DONE state", Detail: err.Error()},
+			}
+		}
+	}
+
+	return h.Read(ctx, d, meta)
+}
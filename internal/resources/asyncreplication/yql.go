@@ -0,0 +1,37 @@
+package asyncreplication
+
+import (
+	"strings"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+)
+
+func prepareCreateReplicationQuery(fullPath string, items []item, opts []helpers.YQLSetting) string {
+	pairs := make([]string, 0, len(items))
+	for _, it := range items {
+		pairs = append(pairs, "`"+helpers.EscapeYQLIdentifier(it.sourcePath)+"` AS `"+helpers.EscapeYQLIdentifier(it.targetPath)+"`")
+	}
+	return "CREATE ASYNC REPLICATION `" + helpers.EscapeYQLIdentifier(fullPath) + "` FOR " +
+		strings.Join(pairs, ", ") + helpers.PrepareYQLWithClause(opts)
+}
+
+func prepareAlterReplicationQuery(fullPath string, opts []helpers.YQLSetting) string {
+	return "ALTER ASYNC REPLICATION `" + helpers.EscapeYQLIdentifier(fullPath) + "` " + helpers.PrepareYQLSetResetClause(opts)
+}
+
+// failoverSettings stop the replication and make the replicas writable.
+func failoverSettings() []helpers.YQLSetting {
+	return []helpers.YQLSetting{
+		helpers.YQLStringSetting("STATE", StateDone),
+		helpers.YQLStringSetting("FAILOVER_MODE", "FORCE"),
+	}
+}
+
+// prepareDropReplicationQuery drops the replication, with cascade the replicas are dropped too.
+func prepareDropReplicationQuery(fullPath string, cascade bool) string {
+	q := "DROP ASYNC REPLICATION `" + helpers.EscapeYQLIdentifier(fullPath) + "`"
+	if cascade {
+		q += " CASCADE"
+	}
+	return q
+}
@@ -0,0 +1,123 @@
+package backupcollection
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ydb "github.com/ydb-platform/ydb-go-sdk/v3"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+// Create runs a backup of the collection. Its id points to the backup directory that appeared
+// in the collection while the backup was running.
+func (h *backupHandler) Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connectionString := d.Get("connection_string").(string)
+	collection := d.Get("collection").(string)
+	incremental := d.Get("incremental").(bool)
+
+	_, database, _, err := helpers.ParseYDBDatabaseEndpoint(connectionString)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: connectionString,
+		AuthCreds:        h.authCreds,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: "failed to initialize DB connection", Detail: err.Error()},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	fullPath := helpers.JoinYDBCatalogPath(database, collectionPath(collection))
+	before, err := listBackups(ctx, db, fullPath)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	q := prepareBackupQuery(collection, incremental)
+	err = db.ExecQuery(ctx, q, tbl.NonIdempotent)
+	if err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: fmt.Sprintf("failed to execute query %q", q), Detail: err.Error()},
+		}
+	}
+
+	after, err := listBackups(ctx, db, fullPath)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	created := newBackups(before, after)
+	if len(created) == 0 {
+		return diag.Errorf("backup of collection %q finished, but no new backup appeared in %q", collection, fullPath)
+	}
+
+	d.SetId(connectionString + "?path=" + collectionPath(collection) + "/" + created[len(created)-1].Name)
+
+	return h.Read(ctx, d, meta)
+}
+
+// Read only checks that the collection still exists: backups removed from the collection later
+// are not run again.
+func (h *backupHandler) Read(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	entity, err := helpers.ParseYDBEntityID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: entity.PrepareFullYDBEndpoint(),
+		AuthCreds:        h.authCreds,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: "failed to initialize table client", Detail: err.Error()},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	collectionFullPath := path.Dir(entity.GetFullEntityPath())
+	_, err = db.Scheme().DescribePath(ctx, collectionFullPath)
+	if err != nil {
+		if ydb.IsOperationErrorSchemeError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to describe backup collection %q: %s", collectionFullPath, err)
+	}
+
+	b := parseBackup(path.Base(entity.GetEntityPath()))
+	_ = d.Set("connection_string", entity.PrepareFullYDBEndpoint())
+	_ = d.Set("collection", collectionName(entity.GetEntityPath()))
+	_ = d.Set("backup_name", b.Name)
+	if b.Type != "" {
+		_ = d.Set("incremental", b.Type == BackupTypeIncremental)
+	}
+	if !b.CreatedAt.IsZero() {
+		_ = d.Set("created_at", b.CreatedAt.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// Update is never called by Terraform: every argument of ydb_backup forces replacement.
+func (h *backupHandler) Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return h.Read(ctx, d, meta)
+}
+
+// Delete only forgets the backup: it stays in the collection until the collection is dropped.
+func (h *backupHandler) Delete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
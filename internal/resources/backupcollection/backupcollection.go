@@ -0,0 +1,100 @@
+package backupcollection
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+const (
+	// CollectionsDir is the directory YDB keeps backup collections in, relative to the database root.
+	CollectionsDir = ".backups/collections"
+
+	StorageCluster = "cluster"
+
+	BackupTypeFull        = "full"
+	BackupTypeIncremental = "incremental"
+
+	// backupTimeLayout is the timestamp YDB prefixes backup directory names with.
+	backupTimeLayout = "20060102150405Z"
+)
+
+// collectionPath returns the path of the collection name relative to the database root.
+func collectionPath(name string) string {
+	return CollectionsDir + "/" + name
+}
+
+// Backup is a backup stored in a collection.
+type Backup struct {
+	Name string
+	// Type is BackupTypeFull or BackupTypeIncremental, empty if the name has an unknown format.
+	Type      string
+	CreatedAt time.Time
+}
+
+// parseBackup parses a backup directory name like 20250101120000Z_full.
+func parseBackup(name string) Backup {
+	b := Backup{Name: name}
+	ts, typ, ok := strings.Cut(name, "_")
+	if !ok {
+		return b
+	}
+	if typ == BackupTypeFull || typ == BackupTypeIncremental {
+		b.Type = typ
+	}
+	if t, err := time.Parse(backupTimeLayout, ts); err == nil {
+		b.CreatedAt = t
+	}
+	return b
+}
+
+// listBackups returns the backups of the collection at fullPath, oldest first.
+func listBackups(ctx context.Context, db *tbl.Driver, fullPath string) ([]Backup, error) {
+	dir, err := db.Scheme().ListDirectory(ctx, fullPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list backup collection %q: %w", fullPath, err)
+	}
+	res := make([]Backup, 0, len(dir.Children))
+	for _, child := range dir.Children {
+		if !child.IsDirectory() {
+			continue
+		}
+		res = append(res, parseBackup(child.Name))
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Name < res[j].Name })
+	return res, nil
+}
+
+// newBackups returns the backups of after missing in before.
+func newBackups(before, after []Backup) []Backup {
+	known := make(map[string]struct{}, len(before))
+	for _, b := range before {
+		known[b.Name] = struct{}{}
+	}
+	var res []Backup
+	for _, b := range after {
+		if _, ok := known[b.Name]; !ok {
+			res = append(res, b)
+		}
+	}
+	return res
+}
+
+func tableFullPaths(database string, tables []interface{}) []string {
+	res := make([]string, 0, len(tables))
+	for _, t := range tables {
+		res = append(res, helpers.JoinYDBCatalogPath(database, t.(string)))
+	}
+	return res
+}
+
+// collectionName returns the name of the collection a backup path points to.
+func collectionName(backupPath string) string {
+	return path.Base(path.Dir(backupPath))
+}
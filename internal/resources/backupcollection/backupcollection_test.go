@@ -0,0 +1,57 @@
+package backupcollection
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrepareCreateCollectionQuery(t *testing.T) {
+	q := prepareCreateCollectionQuery("shop", []string{"/Root/db/shop/orders", "/Root/db/shop/items"}, StorageCluster, true)
+	assert.Equal(t, "CREATE BACKUP COLLECTION `shop` ( TABLE `/Root/db/shop/orders`, TABLE `/Root/db/shop/items` )"+
+		" WITH (STORAGE = 'cluster', INCREMENTAL_BACKUP_ENABLED = 'true')", q)
+}
+
+func TestPrepareAlterCollectionTablesQuery(t *testing.T) {
+	q := prepareAlterCollectionTablesQuery("shop", []string{"/Root/db/shop/items"}, []string{"/Root/db/shop/old"})
+	assert.Equal(t, "ALTER BACKUP COLLECTION `shop` ADD TABLE `/Root/db/shop/items`, DROP TABLE `/Root/db/shop/old`", q)
+}
+
+func TestTablesDiff(t *testing.T) {
+	toAdd, toDrop := tablesDiff([]string{"a", "b"}, []string{"b", "c"})
+	assert.Equal(t, []string{"c"}, toAdd)
+	assert.Equal(t, []string{"a"}, toDrop)
+
+	toAdd, toDrop = tablesDiff([]string{"a", "b"}, []string{"b", "a"})
+	assert.Empty(t, toAdd)
+	assert.Empty(t, toDrop)
+}
+
+func TestPrepareBackupQuery(t *testing.T) {
+	assert.Equal(t, "BACKUP `shop`", prepareBackupQuery("shop", false))
+	assert.Equal(t, "BACKUP `shop` INCREMENTAL", prepareBackupQuery("shop", true))
+	assert.Equal(t, "DROP BACKUP COLLECTION `shop`", prepareDropCollectionQuery("shop"))
+}
+
+func TestParseBackup(t *testing.T) {
+	b := parseBackup("20250102030405Z_incremental")
+	assert.Equal(t, BackupTypeIncremental, b.Type)
+	assert.Equal(t, time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC), b.CreatedAt)
+
+	b = parseBackup("manual")
+	assert.Equal(t, "manual", b.Name)
+	assert.Empty(t, b.Type)
+	assert.True(t, b.CreatedAt.IsZero())
+}
+
+func TestNewBackups(t *testing.T) {
+	before := []Backup{{Name: "20250101000000Z_full"}}
+	after := []Backup{{Name: "20250101000000Z_full"}, {Name: "20250102000000Z_incremental"}}
+	assert.Equal(t, []Backup{{Name: "20250102000000Z_incremental"}}, newBackups(before, after))
+	assert.Empty(t, newBackups(after, after))
+}
+
+func TestCollectionName(t *testing.T) {
+	assert.Equal(t, "shop", collectionName(".backups/collections/shop/20250101000000Z_full"))
+}
@@ -0,0 +1,51 @@
+package backupcollection
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func (h *handler) Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connectionString := d.Get("connection_string").(string)
+	name := d.Get("name").(string)
+
+	_, database, _, err := helpers.ParseYDBDatabaseEndpoint(connectionString)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: connectionString,
+		AuthCreds:        h.authCreds,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: "failed to initialize DB connection", Detail: err.Error()},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	q := prepareCreateCollectionQuery(
+		name,
+		tableFullPaths(database, d.Get("tables").([]interface{})),
+		d.Get("storage").(string),
+		d.Get("incremental_backup_enabled").(bool),
+	)
+	err = db.ExecQuery(ctx, q, tbl.NonIdempotent)
+	if err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: "failed to execute query CREATE BACKUP COLLECTION ...", Detail: err.Error()},
+		}
+	}
+
+	d.SetId(connectionString + "?path=" + collectionPath(name))
+
+	return h.Read(ctx, d, meta)
+}
@@ -0,0 +1,27 @@
+package backupcollection
+
+import (
+	"github.com/ydb-platform/terraform-provider-ydb/internal/resources"
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
+)
+
+type handler struct {
+	authCreds auth.YdbCredentials
+}
+
+func NewHandler(authCreds auth.YdbCredentials) resources.Handler {
+	return &handler{
+		authCreds: authCreds,
+	}
+}
+
+type backupHandler struct {
+	authCreds auth.YdbCredentials
+}
+
+// NewBackupHandler returns the handler of ydb_backup, which runs a backup of a collection.
+func NewBackupHandler(authCreds auth.YdbCredentials) resources.Handler {
+	return &backupHandler{
+		authCreds: authCreds,
+	}
+}
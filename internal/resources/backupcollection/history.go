@@ -0,0 +1,64 @@
+package backupcollection
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
+)
+
+// ReadHistory reads the ydb_backup_history data source.
+func ReadHistory(ctx context.Context, d *schema.ResourceData, authCreds auth.YdbCredentials) diag.Diagnostics {
+	connectionString := d.Get("connection_string").(string)
+	_, database, _, err := helpers.ParseYDBDatabaseEndpoint(connectionString)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	collection := d.Get("collection").(string)
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: connectionString,
+		AuthCreds:        authCreds,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: "failed to initialize DB connection", Detail: err.Error()},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	backups, err := listBackups(ctx, db, helpers.JoinYDBCatalogPath(database, collectionPath(collection)))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	flat := make([]interface{}, 0, len(backups))
+	var lastFull string
+	for _, b := range backups {
+		createdAt := ""
+		if !b.CreatedAt.IsZero() {
+			createdAt = b.CreatedAt.Format(time.RFC3339)
+		}
+		flat = append(flat, map[string]interface{}{
+			"name":       b.Name,
+			"type":       b.Type,
+			"created_at": createdAt,
+		})
+		if b.Type == BackupTypeFull {
+			lastFull = b.Name
+		}
+	}
+
+	d.SetId(connectionString + "?path=" + collectionPath(collection))
+	_ = d.Set("backups", flat)
+	_ = d.Set("last_full_backup", lastFull)
+
+	return nil
+}
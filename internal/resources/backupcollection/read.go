@@ -0,0 +1,50 @@
+package backupcollection
+
+import (
+	"context"
+	"path"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ydb "github.com/ydb-platform/ydb-go-sdk/v3"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func (h *handler) Read(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	entity, err := helpers.ParseYDBEntityID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: entity.PrepareFullYDBEndpoint(),
+		AuthCreds:        h.authCreds,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: "failed to initialize table client", Detail: err.Error()},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	// YDB has no API describing the tables and settings of a collection, so only its existence
+	// is checked and the rest is kept from the configuration.
+	_, err = db.Scheme().DescribePath(ctx, entity.GetFullEntityPath())
+	if err != nil {
+		if ydb.IsOperationErrorSchemeError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to describe backup collection %q: %s", entity.GetFullEntityPath(), err)
+	}
+
+	_ = d.Set("connection_string", entity.PrepareFullYDBEndpoint())
+	_ = d.Set("name", path.Base(entity.GetEntityPath()))
+	_ = d.Set("full_path", entity.GetFullEntityPath())
+
+	return nil
+}
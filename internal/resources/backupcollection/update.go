@@ -0,0 +1,76 @@
+package backupcollection
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+// Update adds and removes the tables of the collection in place, so the collection keeps its
+// backups. The other arguments force replacement.
+func (h *handler) Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.HasChange("tables") {
+		return h.Read(ctx, d, meta)
+	}
+
+	entity, err := helpers.ParseYDBEntityID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	o, n := d.GetChange("tables")
+	database := entity.GetDatabasePath()
+	toAdd, toDrop := tablesDiff(tableFullPaths(database, o.([]interface{})), tableFullPaths(database, n.([]interface{})))
+	if len(toAdd) == 0 && len(toDrop) == 0 {
+		return h.Read(ctx, d, meta)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: entity.PrepareFullYDBEndpoint(),
+		AuthCreds:        h.authCreds,
+	})
+	if err != nil {
+		return diag.Errorf("failed to initialize table client: %s", err)
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	q := prepareAlterCollectionTablesQuery(path.Base(entity.GetEntityPath()), toAdd, toDrop)
+	err = db.ExecQuery(ctx, q, tbl.NonIdempotent)
+	if err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: fmt.Sprintf("failed to execute query %q", q), Detail: err.Error()},
+		}
+	}
+
+	return h.Read(ctx, d, meta)
+}
+
+// tablesDiff returns the tables of newTables missing in oldTables and the tables of oldTables
+// missing in newTables. The order of the tables does not matter.
+func tablesDiff(oldTables, newTables []string) (toAdd, toDrop []string) {
+	known := make(map[string]struct{}, len(oldTables))
+	for _, t := range oldTables {
+		known[t] = struct{}{}
+	}
+	kept := make(map[string]struct{}, len(newTables))
+	for _, t := range newTables {
+		kept[t] = struct{}{}
+		if _, ok := known[t]; !ok {
+			toAdd = append(toAdd, t)
+		}
+	}
+	for _, t := range oldTables {
+		if _, ok := kept[t]; !ok {
+			toDrop = append(toDrop, t)
+		}
+	}
+	return toAdd, toDrop
+}
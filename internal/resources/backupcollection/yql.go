@@ -0,0 +1,70 @@
+package backupcollection
+
+import (
+	"strings"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+)
+
+func prepareCreateCollectionQuery(name string, tables []string, storage string, incremental bool) string {
+	var b strings.Builder
+	b.WriteString("CREATE BACKUP COLLECTION `")
+	b.WriteString(helpers.EscapeYQLIdentifier(name))
+	b.WriteString("` (")
+	for i, t := range tables {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		b.WriteString(" TABLE `")
+		b.WriteString(helpers.EscapeYQLIdentifier(t))
+		b.WriteString("`")
+	}
+	b.WriteString(" )")
+	incrementalValue := "false"
+	if incremental {
+		incrementalValue = "true"
+	}
+	b.WriteString(helpers.PrepareYQLWithClause([]helpers.YQLSetting{
+		helpers.YQLStringSetting("STORAGE", storage),
+		helpers.YQLStringSetting("INCREMENTAL_BACKUP_ENABLED", incrementalValue),
+	}))
+	return b.String()
+}
+
+func prepareAlterCollectionTablesQuery(name string, toAdd, toDrop []string) string {
+	var b strings.Builder
+	b.WriteString("ALTER BACKUP COLLECTION `")
+	b.WriteString(helpers.EscapeYQLIdentifier(name))
+	b.WriteString("`")
+	first := true
+	entry := func(action, table string) {
+		if !first {
+			b.WriteString(",")
+		}
+		first = false
+		b.WriteString(" ")
+		b.WriteString(action)
+		b.WriteString(" TABLE `")
+		b.WriteString(helpers.EscapeYQLIdentifier(table))
+		b.WriteString("`")
+	}
+	for _, t := range toAdd {
+		entry("ADD", t)
+	}
+	for _, t := range toDrop {
+		entry("DROP", t)
+	}
+	return b.String()
+}
+
+func prepareDropCollectionQuery(name string) string {
+	return "DROP BACKUP COLLECTION `" + helpers.EscapeYQLIdentifier(name) + "`"
+}
+
+func prepareBackupQuery(name string, incremental bool) string {
+	q := "BACKUP `" + helpers.EscapeYQLIdentifier(name) + "`"
+	if incremental {
+		q += " INCREMENTAL"
+	}
+	return q
+}
@@ -5,7 +5,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
 
 	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
@@ -49,9 +48,7 @@ func (h *handler) Create(ctx context.Context, d *schema.ResourceData, meta inter
 	}()
 
 	q := PrepareCreateRequest(cdcResource)
-	err = db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
-		return s.ExecuteSchemeQuery(ctx, q)
-	})
+	err = db.ExecuteSchemeQuery(ctx, q, tbl.NonIdempotent)
 	if err != nil {
 		return diag.Diagnostics{
 			diag.Diagnostic{
@@ -62,14 +59,23 @@ func (h *handler) Create(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 	}
 
-	opts := topicoptions.AlterWithAddConsumers(cdcResource.Consumers...)
-
-	err = db.Topic().Alter(ctx, helpers.TrimPath(cdcResource.getTablePath())+"/"+cdcResource.Name, opts)
-	if err != nil {
+	if err = addConsumers(ctx, db, cdcResource); err != nil {
 		return diag.FromErr(err)
 	}
 
-	d.SetId(cdcResource.getConnectionString() + "?path=" + cdcResource.getTablePath() + "/" + cdcResource.Name)
+	d.SetId(changefeedID(cdcResource))
 
 	return h.Read(ctx, d, meta)
 }
+
+func changefeedID(r *changeDataCaptureSettings) string {
+	return r.getConnectionString() + "?path=" + r.getTablePath() + "/" + r.Name
+}
+
+func addConsumers(ctx context.Context, db *tbl.Driver, r *changeDataCaptureSettings) error {
+	opts := topicoptions.AlterWithAddConsumers(r.Consumers...)
+
+	return db.Retry(ctx, "alter changefeed topic", tbl.NonIdempotent, func(ctx context.Context) error {
+		return db.Topic().Alter(ctx, helpers.TrimPath(r.getTablePath())+"/"+r.Name, opts)
+	})
+}
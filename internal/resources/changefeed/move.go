@@ -0,0 +1,47 @@
+package changefeed
+
+import (
+	"context"
+	"fmt"
+
+	ydb "github.com/ydb-platform/ydb-go-sdk/v3"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+// moveChangefeed points the changefeed resource to the table it is configured for now. A
+// changefeed is moved together with its table when the table is renamed, so a changefeed already
+// present on the new table is adopted. Otherwise the changefeed is created on the new table and
+// dropped from the old one. It returns the new resource ID and whether the changefeed was created
+// from its current definition.
+func moveChangefeed(ctx context.Context, db *tbl.Driver, r *changeDataCaptureSettings) (string, bool, error) {
+	id := changefeedID(r)
+	entity, err := helpers.ParseYDBEntityID(id)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to parse changefeed entity: %w", err)
+	}
+
+	description, err := db.DescribeTable(ctx, parseTablePathFromCDCEntity(entity.GetFullEntityPath()))
+	if err != nil {
+		return "", false, fmt.Errorf("failed to describe table %q: %w", r.getTablePath(), err)
+	}
+	for _, cdc := range description.Changefeeds {
+		if cdc.Name == r.Name {
+			return id, false, nil
+		}
+	}
+
+	if err = db.ExecuteSchemeQuery(ctx, PrepareCreateRequest(r), tbl.NonIdempotent); err != nil {
+		return "", false, fmt.Errorf("failed to create changefeed %q on table %q: %w", r.Name, r.getTablePath(), err)
+	}
+	if err = addConsumers(ctx, db, r); err != nil {
+		return "", false, fmt.Errorf("failed to add consumers of changefeed %q: %w", r.Name, err)
+	}
+	oldTablePath := parseTablePathFromCDCEntity(r.Entity.GetEntityPath())
+	err = db.ExecuteSchemeQuery(ctx, PrepareDropRequest(oldTablePath, r.Name), tbl.NonIdempotent)
+	if err != nil && !ydb.IsOperationErrorSchemeError(err) {
+		return "", false, fmt.Errorf("failed to drop changefeed %q from table %q: %w", r.Name, oldTablePath, err)
+	}
+	return id, true, nil
+}
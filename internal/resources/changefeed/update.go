@@ -42,6 +42,18 @@ func (h *handler) Update(ctx context.Context, d *schema.ResourceData, meta inter
 		_ = db.Close(ctx)
 	}()
 
+	// The changefeed follows its table, e.g. after the table is renamed.
+	if d.HasChanges("table_path", "table_id") {
+		id, created, err := moveChangefeed(ctx, db, cdcResource)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId(id)
+		if created {
+			return h.Read(ctx, d, meta)
+		}
+	}
+
 	topicPath := helpers.TrimPath(cdcResource.getTablePath()) + "/" + cdcResource.Name
 	desc, err := db.Topic().Describe(ctx, topicPath)
 	if err != nil {
@@ -49,7 +61,9 @@ func (h *handler) Update(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	alterConsumersOptions := mergeConsumerSettings(d, desc.Consumers)
-	err = db.Topic().Alter(ctx, topicPath, alterConsumersOptions...)
+	err = db.Retry(ctx, "alter changefeed topic", tbl.NonIdempotent, func(ctx context.Context) error {
+		return db.Topic().Alter(ctx, topicPath, alterConsumersOptions...)
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -0,0 +1,74 @@
+package directory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func (h *handler) Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connectionString := d.Get("connection_string").(string)
+
+	_, database, _, err := helpers.ParseYDBDatabaseEndpoint(connectionString)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	fullPath := directoryFullPath(database, d.Get("path").(string))
+	if fullPath == database {
+		return diag.Errorf("directory path must not be the database root")
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: connectionString,
+		AuthCreds:        h.authCreds,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: "failed to initialize DB connection", Detail: err.Error()},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	missing, err := missingDirectories(database, fullPath, func(p string) (bool, error) {
+		return directoryExists(ctx, db, p)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	switch {
+	case len(missing) == 0:
+		return diag.Errorf("directory %q already exists, import it to manage it with Terraform", fullPath)
+	case len(missing) > 1 && !d.Get("recursive").(bool):
+		return diag.Errorf("parent directory %q does not exist, create it first or set recursive = true", missing[len(missing)-2])
+	}
+
+	for _, p := range missing {
+		err = db.Retry(ctx, "make directory", tbl.Idempotent, func(ctx context.Context) error {
+			return db.Scheme().MakeDirectory(ctx, p)
+		})
+		if err != nil {
+			return diag.Diagnostics{
+				{Severity: diag.Error, Summary: fmt.Sprintf("failed to create directory %q", p), Detail: err.Error()},
+			}
+		}
+	}
+
+	if !d.Get("inherit_permissions").(bool) {
+		if err = setInheritance(ctx, db, fullPath, false); err != nil {
+			return diag.Diagnostics{
+				{Severity: diag.Error, Summary: "failed to interrupt permissions inheritance of " + fullPath, Detail: err.Error()},
+			}
+		}
+	}
+
+	d.SetId(connectionString + "?path=" + helpers.RelativizeYDBCatalogPath(database, fullPath))
+
+	return h.Read(ctx, d, meta)
+}
@@ -0,0 +1,71 @@
+package directory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ydb "github.com/ydb-platform/ydb-go-sdk/v3"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func (h *handler) Delete(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	entity, err := helpers.ParseYDBEntityID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	fullPath := entity.GetFullEntityPath()
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: entity.PrepareFullYDBEndpoint(),
+		AuthCreds:        h.authCreds,
+	})
+	if err != nil {
+		return diag.Errorf("failed to initialize table client: %s", err)
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	if !d.Get("remove_only_if_empty").(bool) {
+		if err = removeRecursive(ctx, db, fullPath); err != nil && !ydb.IsOperationErrorSchemeError(err) {
+			return diag.Diagnostics{
+				{Severity: diag.Error, Summary: fmt.Sprintf("failed to remove directory %q with its contents", fullPath), Detail: err.Error()},
+			}
+		}
+		return nil
+	}
+
+	dir, err := db.Scheme().ListDirectory(ctx, fullPath)
+	if err != nil {
+		if ydb.IsOperationErrorSchemeError(err) {
+			return nil
+		}
+		return diag.Errorf("failed to list directory %q: %s", fullPath, err)
+	}
+	if len(dir.Children) > 0 {
+		// Keep the resource in state: the directory still exists.
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("directory %q is not empty", fullPath),
+				Detail: fmt.Sprintf("The directory contains %d entries not managed by this resource. Remove them first, "+
+					"or set remove_only_if_empty = false to remove them together with the directory, including unmanaged tables and topics.", len(dir.Children)),
+			},
+		}
+	}
+
+	err = db.Retry(ctx, "remove directory", tbl.NonIdempotent, func(ctx context.Context) error {
+		return db.Scheme().RemoveDirectory(ctx, fullPath)
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: fmt.Sprintf("failed to remove directory %q", fullPath), Detail: err.Error()},
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,212 @@
+package directory
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Scheme_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Scheme"
+	ydb "github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	"github.com/ydb-platform/terraform-provider-ydb/internal/retry"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+// entryTypes maps scheme entry types to the names used in Terraform.
+var entryTypes = map[scheme.EntryType]string{
+	scheme.EntryTypeUnknown:        "unknown",
+	scheme.EntryDirectory:          "directory",
+	scheme.EntryTable:              "table",
+	scheme.EntryPersQueueGroup:     "pers_queue_group",
+	scheme.EntryDatabase:           "database",
+	scheme.EntryRtmrVolume:         "rtmr_volume",
+	scheme.EntryBlockStoreVolume:   "block_store_volume",
+	scheme.EntryCoordinationNode:   "coordination_node",
+	scheme.EntryTopic:              "topic",
+	scheme.EntryColumnStore:        "column_store",
+	scheme.EntryColumnTable:        "column_table",
+	scheme.EntryExternalTable:      "external_table",
+	scheme.EntryExternalDataSource: "external_data_source",
+}
+
+// EntryTypeNames returns the names of all scheme entry types.
+func EntryTypeNames() []string {
+	res := make([]string, 0, len(entryTypes))
+	for t := scheme.EntryTypeUnknown; t <= scheme.EntryExternalDataSource; t++ {
+		res = append(res, entryTypes[t])
+	}
+	return res
+}
+
+func entryTypeName(t scheme.EntryType) string {
+	if name, ok := entryTypes[t]; ok {
+		return name
+	}
+	return entryTypes[scheme.EntryTypeUnknown]
+}
+
+// isSystemEntry reports whether name is a service directory such as .sys or .metadata.
+func isSystemEntry(name string) bool {
+	return strings.HasPrefix(name, ".")
+}
+
+// directoryFullPath returns the absolute path of the directory p, relative to database or
+// absolute, without a trailing slash.
+func directoryFullPath(database, p string) string {
+	return strings.TrimSuffix(helpers.JoinYDBCatalogPath(database, p), "/")
+}
+
+// SuppressPathDiff suppresses the diff of paths naming the same directory, e.g. a/b, /a/b/ and
+// the absolute /local/a/b.
+func SuppressPathDiff(_, oldValue, newValue string, d *schema.ResourceData) bool {
+	if oldValue == newValue {
+		return true
+	}
+	database := ""
+	if cs, _ := d.Get("connection_string").(string); cs != "" {
+		if _, db, _, err := helpers.ParseYDBDatabaseEndpoint(cs); err == nil {
+			database = db
+		}
+	}
+	if database == "" {
+		return helpers.TrimPath(oldValue) == helpers.TrimPath(newValue)
+	}
+	return directoryFullPath(database, oldValue) == directoryFullPath(database, newValue)
+}
+
+// missingDirectories returns fullPath and its ancestors below database that do not exist yet,
+// starting with the outermost one. exists is called from the innermost path up and stops at the
+// first existing directory.
+func missingDirectories(database, fullPath string, exists func(p string) (bool, error)) ([]string, error) {
+	var missing []string
+	for p := fullPath; p != database && strings.HasPrefix(p, database+"/"); p = path.Dir(p) {
+		ok, err := exists(p)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			break
+		}
+		missing = append([]string{p}, missing...)
+	}
+	return missing, nil
+}
+
+func directoryExists(ctx context.Context, db *tbl.Driver, p string) (bool, error) {
+	entry, err := db.Scheme().DescribePath(ctx, p)
+	if err != nil {
+		if ydb.IsOperationErrorSchemeError(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to describe path %q: %w", p, err)
+	}
+	if !entry.IsDirectory() && !entry.IsDatabase() {
+		return false, fmt.Errorf("path %q exists and is a %s, not a directory", p, entryTypeName(entry.Type))
+	}
+	return true, nil
+}
+
+// setInheritance enables or interrupts inheritance of parent permissions on fullPath. The SDK
+// scheme client does not expose this flag, so the request goes to the scheme service directly.
+func setInheritance(ctx context.Context, db *tbl.Driver, fullPath string, inherit bool) error {
+	client := Ydb_Scheme_V1.NewSchemeServiceClient(ydb.GRPCConn(db.Driver))
+	return db.Retry(ctx, "modify permissions inheritance", tbl.Idempotent, func(ctx context.Context) error {
+		resp, err := client.ModifyPermissions(ctx, &Ydb_Scheme.ModifyPermissionsRequest{
+			Path:        fullPath,
+			Inheritance: &Ydb_Scheme.ModifyPermissionsRequest_InterruptInheritance{InterruptInheritance: !inherit},
+		})
+		if err != nil {
+			return fmt.Errorf("modify_permissions problem: %w", err)
+		}
+		if op := resp.GetOperation(); op.GetStatus() != Ydb.StatusIds_SUCCESS {
+			return fmt.Errorf("modify permissions operation code not success: %w", &retry.StatusError{Status: op.GetStatus(), Issues: op.GetIssues()})
+		}
+		return nil
+	})
+}
+
+// Entry is a scheme object found by ListEntries.
+type Entry struct {
+	// Path is relative to the database root.
+	Path  string
+	Name  string
+	Type  string
+	Owner string
+}
+
+// ListEntries lists children of fullPath, descending into subdirectories when recursive is set.
+// Service directories (.sys, .metadata, ...) are skipped.
+func ListEntries(ctx context.Context, db *tbl.Driver, database, fullPath string, recursive bool) ([]Entry, error) {
+	dir, err := db.Scheme().ListDirectory(ctx, fullPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list directory %q: %w", fullPath, err)
+	}
+
+	var res []Entry
+	for _, child := range dir.Children {
+		if isSystemEntry(child.Name) {
+			continue
+		}
+		childPath := path.Join(fullPath, child.Name)
+		res = append(res, Entry{
+			Path:  helpers.RelativizeYDBCatalogPath(database, childPath),
+			Name:  child.Name,
+			Type:  entryTypeName(child.Type),
+			Owner: child.Owner,
+		})
+		if recursive && child.IsDirectory() {
+			nested, err := ListEntries(ctx, db, database, childPath, true)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, nested...)
+		}
+	}
+	return res, nil
+}
+
+// removeRecursive removes fullPath with everything inside it.
+func removeRecursive(ctx context.Context, db *tbl.Driver, fullPath string) error {
+	dir, err := db.Scheme().ListDirectory(ctx, fullPath)
+	if err != nil {
+		return fmt.Errorf("failed to list directory %q: %w", fullPath, err)
+	}
+	for _, child := range dir.Children {
+		if err = removeEntry(ctx, db, path.Join(fullPath, child.Name), child); err != nil {
+			return err
+		}
+	}
+	return db.Retry(ctx, "remove directory", tbl.NonIdempotent, func(ctx context.Context) error {
+		return db.Scheme().RemoveDirectory(ctx, fullPath)
+	})
+}
+
+func removeEntry(ctx context.Context, db *tbl.Driver, fullPath string, entry scheme.Entry) error {
+	id := "`" + helpers.EscapeYQLIdentifier(fullPath) + "`"
+	switch entry.Type {
+	case scheme.EntryDirectory:
+		return removeRecursive(ctx, db, fullPath)
+	case scheme.EntryTable, scheme.EntryColumnTable:
+		return db.ExecuteSchemeQuery(ctx, "DROP TABLE "+id, tbl.NonIdempotent)
+	case scheme.EntryColumnStore:
+		return db.ExecuteSchemeQuery(ctx, "DROP TABLESTORE "+id, tbl.NonIdempotent)
+	case scheme.EntryTopic, scheme.EntryPersQueueGroup:
+		return db.ExecQuery(ctx, "DROP TOPIC "+id, tbl.NonIdempotent)
+	case scheme.EntryExternalTable:
+		return db.ExecQuery(ctx, "DROP EXTERNAL TABLE "+id, tbl.NonIdempotent)
+	case scheme.EntryExternalDataSource:
+		return db.ExecQuery(ctx, "DROP EXTERNAL DATA SOURCE "+id, tbl.NonIdempotent)
+	case scheme.EntryCoordinationNode:
+		return db.Retry(ctx, "drop coordination node", tbl.NonIdempotent, func(ctx context.Context) error {
+			return db.Coordination().DropNode(ctx, fullPath)
+		})
+	default:
+		return fmt.Errorf("cannot remove %q: removing entries of type %s is not supported", fullPath, entryTypeName(entry.Type))
+	}
+}
@@ -0,0 +1,79 @@
+package directory
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMissingDirectories(t *testing.T) {
+	existing := map[string]bool{"/local/a": true}
+	exists := func(p string) (bool, error) { return existing[p], nil }
+
+	testData := []struct {
+		testName string
+		fullPath string
+		expected []string
+	}{
+		{
+			testName: "exists",
+			fullPath: "/local/a",
+		},
+		{
+			testName: "parent exists",
+			fullPath: "/local/a/b",
+			expected: []string{"/local/a/b"},
+		},
+		{
+			testName: "several levels",
+			fullPath: "/local/a/b/c",
+			expected: []string{"/local/a/b", "/local/a/b/c"},
+		},
+		{
+			testName: "up to database root",
+			fullPath: "/local/x/y",
+			expected: []string{"/local/x", "/local/x/y"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.testName, func(t *testing.T) {
+			missing, err := missingDirectories("/local", v.fullPath, exists)
+			require.NoError(t, err)
+			assert.Equal(t, v.expected, missing)
+		})
+	}
+
+	errDescribe := errors.New("describe failed")
+	_, err := missingDirectories("/local", "/local/a/b", func(string) (bool, error) { return false, errDescribe })
+	assert.ErrorIs(t, err, errDescribe)
+}
+
+func TestEntryTypeNames(t *testing.T) {
+	names := EntryTypeNames()
+	assert.Len(t, names, len(entryTypes))
+	assert.Contains(t, names, "directory")
+	assert.Contains(t, names, "column_table")
+	assert.NotContains(t, names, "")
+}
+
+func TestSuppressPathDiff(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"connection_string": {Type: schema.TypeString, Optional: true},
+		"path":              {Type: schema.TypeString, Optional: true},
+	}
+	d := schema.TestResourceDataRaw(t, s, map[string]interface{}{
+		"connection_string": "grpc://localhost:2136/?database=/local",
+	})
+	assert.True(t, SuppressPathDiff("path", "a/b", "/a/b/", d))
+	assert.True(t, SuppressPathDiff("path", "a/b", "/local/a/b", d))
+	assert.True(t, SuppressPathDiff("path", "a/b", "/local/a/b/", d))
+	assert.False(t, SuppressPathDiff("path", "a/b", "a/c", d))
+
+	noConnection := schema.TestResourceDataRaw(t, s, map[string]interface{}{})
+	assert.True(t, SuppressPathDiff("path", "a/b", "/a/b/", noConnection))
+	assert.False(t, SuppressPathDiff("path", "a/b", "a", noConnection))
+}
@@ -0,0 +1,65 @@
+package directory
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
+)
+
+// ReadEntries reads the ydb_scheme_entries data source.
+func ReadEntries(ctx context.Context, d *schema.ResourceData, authCreds auth.YdbCredentials) diag.Diagnostics {
+	connectionString := d.Get("connection_string").(string)
+	_, database, _, err := helpers.ParseYDBDatabaseEndpoint(connectionString)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	fullPath := helpers.JoinYDBCatalogPath(database, d.Get("path").(string))
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: connectionString,
+		AuthCreds:        authCreds,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: "failed to initialize DB connection", Detail: err.Error()},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	entries, err := ListEntries(ctx, db, database, fullPath, d.Get("recursive").(bool))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	types := make(map[string]struct{})
+	for _, t := range d.Get("types").(*schema.Set).List() {
+		types[t.(string)] = struct{}{}
+	}
+	flat := make([]interface{}, 0, len(entries))
+	paths := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if _, ok := types[e.Type]; len(types) > 0 && !ok {
+			continue
+		}
+		flat = append(flat, map[string]interface{}{
+			"name":  e.Name,
+			"path":  e.Path,
+			"type":  e.Type,
+			"owner": e.Owner,
+		})
+		paths = append(paths, e.Path)
+	}
+
+	d.SetId(connectionString + "?path=" + helpers.RelativizeYDBCatalogPath(database, fullPath))
+	_ = d.Set("entries", flat)
+	_ = d.Set("paths", paths)
+
+	return nil
+}
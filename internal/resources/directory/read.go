@@ -0,0 +1,54 @@
+package directory
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ydb "github.com/ydb-platform/ydb-go-sdk/v3"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func (h *handler) Read(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	entity, err := helpers.ParseYDBEntityID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: entity.PrepareFullYDBEndpoint(),
+		AuthCreds:        h.authCreds,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: "failed to initialize table client", Detail: err.Error()},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	entry, err := db.Scheme().DescribePath(ctx, entity.GetFullEntityPath())
+	if err != nil {
+		if ydb.IsOperationErrorSchemeError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to describe path %q: %s", entity.GetFullEntityPath(), err)
+	}
+	if !entry.IsDirectory() {
+		return diag.Errorf("path %q is a %s, not a directory", entity.GetFullEntityPath(), entryTypeName(entry.Type))
+	}
+
+	_ = d.Set("connection_string", entity.PrepareFullYDBEndpoint())
+	// Keep the configured spelling (relative, absolute, with slashes) of the same path.
+	if p := d.Get("path").(string); p == "" || directoryFullPath(entity.GetDatabasePath(), p) != entity.GetFullEntityPath() {
+		_ = d.Set("path", entity.GetEntityPath())
+	}
+	_ = d.Set("full_path", entity.GetFullEntityPath())
+	_ = d.Set("owner", entry.Owner)
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package directory
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+// Update changes permissions inheritance; `recursive` and `remove_only_if_empty` only affect
+// create and destroy and are kept in state.
+func (h *handler) Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.HasChange("inherit_permissions") {
+		return h.Read(ctx, d, meta)
+	}
+
+	entity, err := helpers.ParseYDBEntityID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: entity.PrepareFullYDBEndpoint(),
+		AuthCreds:        h.authCreds,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: "failed to initialize table client", Detail: err.Error()},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	if err = setInheritance(ctx, db, entity.GetFullEntityPath(), d.Get("inherit_permissions").(bool)); err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: "failed to change permissions inheritance of " + entity.GetFullEntityPath(), Detail: err.Error()},
+		}
+	}
+
+	return h.Read(ctx, d, meta)
+}
@@ -29,7 +29,7 @@ func (h *Handler) Update(ctx context.Context, d *schema.ResourceData, meta inter
 	defer func() { _ = db.Close(ctx) }()
 
 	q := PrepareDataSourceQuery(r.Entity.GetFullEntityPath(), r)
-	err = db.Query().Exec(ctx, q)
+	err = db.ExecQuery(ctx, q, tbl.Idempotent)
 	if err != nil {
 		return diag.Errorf("failed to update external data source: %s", err)
 	}
@@ -33,7 +33,7 @@ func (h *Handler) Create(ctx context.Context, d *schema.ResourceData, meta inter
 	fullPath := databaseURL.Query().Get("database") + "/" + r.Path
 
 	q := PrepareCreateQuery(fullPath, r)
-	err = db.Query().Exec(ctx, q)
+	err = db.ExecQuery(ctx, q, tbl.NonIdempotent)
 	if err != nil {
 		return diag.Errorf("failed to create external table: %s", err)
 	}
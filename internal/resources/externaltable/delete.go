@@ -26,7 +26,7 @@ func (h *Handler) Delete(ctx context.Context, d *schema.ResourceData, _ interfac
 	defer func() { _ = db.Close(ctx) }()
 
 	q := PrepareDropQuery(entity.GetFullEntityPath())
-	err = db.Query().Exec(ctx, q)
+	err = db.ExecQuery(ctx, q, tbl.NonIdempotent)
 	if err != nil {
 		return diag.Errorf("failed to drop external table %q: %s", entity.GetEntityPath(), err)
 	}
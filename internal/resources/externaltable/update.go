@@ -5,8 +5,34 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
 )
 
-func (h *Handler) Update(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
-	return diag.Errorf("update is not supported for external table, all changes require recreation")
+func (h *Handler) Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	r, err := resourceSchemaToResource(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if r.Entity == nil {
+		return diag.Errorf("external table id is required for update")
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: r.getConnectionString(),
+		AuthCreds:        h.authCreds,
+	})
+	if err != nil {
+		return diag.Errorf("failed to initialize client: %s", err)
+	}
+	defer func() { _ = db.Close(ctx) }()
+
+	q := PrepareCreateOrReplaceQuery(r.Entity.GetFullEntityPath(), r)
+	err = db.ExecQuery(ctx, q, tbl.Idempotent)
+	if err != nil {
+		return diag.Errorf("failed to update external table: %s", err)
+	}
+
+	return h.Read(ctx, d, meta)
 }
@@ -1,8 +1,18 @@
 package externaltable
 
 func PrepareCreateQuery(fullPath string, r *Resource) string {
+	return prepareQuery("CREATE EXTERNAL TABLE `", fullPath, r)
+}
+
+// PrepareCreateOrReplaceQuery atomically replaces the definition of an existing external table,
+// since YDB has no ALTER EXTERNAL TABLE.
+func PrepareCreateOrReplaceQuery(fullPath string, r *Resource) string {
+	return prepareQuery("CREATE OR REPLACE EXTERNAL TABLE `", fullPath, r)
+}
+
+func prepareQuery(prefix string, fullPath string, r *Resource) string {
 	buf := make([]byte, 0, 512)
-	buf = append(buf, "CREATE EXTERNAL TABLE `"...)
+	buf = append(buf, prefix...)
 	buf = append(buf, fullPath...)
 	buf = append(buf, "` ("...)
 
@@ -56,3 +56,17 @@ func TestPrepareDropQuery(t *testing.T) {
 	got := PrepareDropQuery("/local/s3_test_data")
 	assert.Equal(t, "DROP EXTERNAL TABLE `/local/s3_test_data`", got)
 }
+
+func TestPrepareCreateOrReplaceQuery(t *testing.T) {
+	r := &Resource{
+		DataSourcePath: "bucket",
+		Location:       "folder/v2",
+		Format:         "json_each_row",
+		Columns: []ColumnDef{
+			{Name: "key", Type: "Utf8", NotNull: true},
+			{Name: "payload", Type: "Json"},
+		},
+	}
+	got := PrepareCreateOrReplaceQuery("/local/s3_test_data", r)
+	assert.Equal(t, `CREATE OR REPLACE EXTERNAL TABLE `+"`/local/s3_test_data`"+` ( `+"`key`"+` Utf8 NOT NULL, `+"`payload`"+` Json ) WITH ( DATA_SOURCE = "bucket", LOCATION = "folder/v2", FORMAT = "json_each_row" )`, got)
+}
@@ -0,0 +1,13 @@
+package group
+
+import "github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+
+const selectGroupQuery = "SELECT Sid FROM `.sys/auth_groups` WHERE Sid = $sid"
+
+func prepareCreateGroupQuery(name string) string {
+	return "CREATE GROUP `" + helpers.EscapeYQLIdentifier(name) + "`"
+}
+
+func prepareDropGroupQuery(name string) string {
+	return "DROP GROUP `" + helpers.EscapeYQLIdentifier(name) + "`"
+}
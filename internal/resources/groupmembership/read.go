@@ -0,0 +1,58 @@
+package groupmembership
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ydb "github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func (h *handler) Read(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	entity, err := helpers.ParseYDBEntityID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	group, member, err := parseMembershipPath(entity.GetEntityPath())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: entity.PrepareFullYDBEndpoint(),
+		AuthCreds:        h.authCreds,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: "failed to initialize table client", Detail: err.Error()},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	_, err = db.Query().QueryRow(ctx, selectMembershipQuery,
+		query.WithParameters(ydb.ParamsBuilder().
+			Param("$group").Text(group).
+			Param("$member").Text(member).
+			Build()),
+	)
+	if errors.Is(err, query.ErrNoRows) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("failed to read membership of %q in group %q from .sys/auth_group_members: %s", member, group, err)
+	}
+
+	_ = d.Set("connection_string", entity.PrepareFullYDBEndpoint())
+	_ = d.Set("group", group)
+	_ = d.Set("member", member)
+
+	return nil
+}
@@ -0,0 +1,13 @@
+package groupmembership
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Update is never called by Terraform: every argument of ydb_group_membership forces replacement.
+func (h *handler) Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return h.Read(ctx, d, meta)
+}
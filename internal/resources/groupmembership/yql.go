@@ -0,0 +1,33 @@
+package groupmembership
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+)
+
+const selectMembershipQuery = "SELECT GroupSid FROM `.sys/auth_group_members` WHERE GroupSid = $group AND MemberSid = $member"
+
+func prepareAddMemberQuery(group, member string) string {
+	return "ALTER GROUP `" + helpers.EscapeYQLIdentifier(group) + "` ADD USER `" + helpers.EscapeYQLIdentifier(member) + "`"
+}
+
+func prepareDropMemberQuery(group, member string) string {
+	return "ALTER GROUP `" + helpers.EscapeYQLIdentifier(group) + "` DROP USER `" + helpers.EscapeYQLIdentifier(member) + "`"
+}
+
+// membershipPath is the `?path=` part of the resource id.
+func membershipPath(group, member string) string {
+	return group + "/" + member
+}
+
+// parseMembershipPath splits a membership path at the first slash: group names cannot contain
+// slashes, while member SIDs of external users may.
+func parseMembershipPath(path string) (group, member string, err error) {
+	group, member, ok := strings.Cut(path, "/")
+	if !ok || group == "" || member == "" {
+		return "", "", fmt.Errorf("failed to parse group membership %q: expected <group>/<member>", path)
+	}
+	return group, member, nil
+}
@@ -0,0 +1,30 @@
+package groupmembership
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMembershipQueries(t *testing.T) {
+	assert.Equal(t, "ALTER GROUP `admins` ADD USER `alice`", prepareAddMemberQuery("admins", "alice"))
+	assert.Equal(t, "ALTER GROUP `admins` DROP USER `we``ird`", prepareDropMemberQuery("admins", "we`ird"))
+}
+
+func TestParseMembershipPath(t *testing.T) {
+	group, member, err := parseMembershipPath(membershipPath("admins", "alice"))
+	require.NoError(t, err)
+	assert.Equal(t, "admins", group)
+	assert.Equal(t, "alice", member)
+
+	group, member, err = parseMembershipPath("admins/robot/sa@as")
+	require.NoError(t, err)
+	assert.Equal(t, "admins", group)
+	assert.Equal(t, "robot/sa@as", member)
+
+	for _, path := range []string{"admins", "admins/", "/alice", ""} {
+		_, _, err = parseMembershipPath(path)
+		assert.Error(t, err, path)
+	}
+}
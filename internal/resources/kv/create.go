@@ -8,6 +8,7 @@ import (
 
 	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
 	"github.com/ydb-platform/terraform-provider-ydb/internal/kv"
+	"github.com/ydb-platform/terraform-provider-ydb/internal/retry"
 	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
 )
 
@@ -27,6 +28,7 @@ func (h *handler) Create(ctx context.Context, d *schema.ResourceData, meta inter
 	conn, err := kv.CreateDBConnection(ctx, kv.ClientParams{
 		DatabaseEndpoint: kvResource.Endpoint,
 		UseTLS:           kvResource.UseTLS,
+		TLSConfig:        h.authCreds.TLSConfig,
 	})
 	if err != nil {
 		return diag.Diagnostics{
@@ -59,7 +61,9 @@ func (h *handler) Create(ctx context.Context, d *schema.ResourceData, meta inter
 		},
 	}, conn)
 
-	err = CreateKvVolume(ctx, kvResource, stub)
+	err = retry.Do(ctx, h.authCreds.RetryPolicy, "create kv volume", retry.NonIdempotent, func(ctx context.Context) error {
+		return CreateKvVolume(ctx, kvResource, stub)
+	})
 	if err != nil {
 		return diag.Diagnostics{
 			diag.Diagnostic{
@@ -8,6 +8,7 @@ import (
 
 	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
 	"github.com/ydb-platform/terraform-provider-ydb/internal/kv"
+	"github.com/ydb-platform/terraform-provider-ydb/internal/retry"
 	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
 )
 
@@ -28,6 +29,7 @@ func (h *handler) Delete(ctx context.Context, d *schema.ResourceData, cfg interf
 	conn, err := kv.CreateDBConnection(ctx, kv.ClientParams{
 		DatabaseEndpoint: kvResource.Endpoint,
 		UseTLS:           kvResource.UseTLS,
+		TLSConfig:        h.authCreds.TLSConfig,
 	})
 	if err != nil {
 		return diag.Errorf("failed to initialize kv client: %s", err)
@@ -54,5 +56,7 @@ func (h *handler) Delete(ctx context.Context, d *schema.ResourceData, cfg interf
 		},
 	}, conn)
 
-	return diag.FromErr(DropKvVolume(ctx, kvResource, stub))
+	return diag.FromErr(retry.Do(ctx, h.authCreds.RetryPolicy, "drop kv volume", retry.NonIdempotent, func(ctx context.Context) error {
+		return DropKvVolume(ctx, kvResource, stub)
+	}))
 }
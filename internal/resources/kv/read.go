@@ -5,9 +5,11 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ydb-platform/ydb-go-genproto/draft/protos/Ydb_KeyValue"
 
 	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
 	"github.com/ydb-platform/terraform-provider-ydb/internal/kv"
+	"github.com/ydb-platform/terraform-provider-ydb/internal/retry"
 	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
 )
 
@@ -28,6 +30,7 @@ func (h *handler) Read(ctx context.Context, d *schema.ResourceData, cfg interfac
 	conn, err := kv.CreateDBConnection(ctx, kv.ClientParams{
 		DatabaseEndpoint: kvResource.Endpoint,
 		UseTLS:           kvResource.UseTLS,
+		TLSConfig:        h.authCreds.TLSConfig,
 	})
 	if err != nil {
 		return diag.Diagnostics{
@@ -60,7 +63,11 @@ func (h *handler) Read(ctx context.Context, d *schema.ResourceData, cfg interfac
 		},
 	}, conn)
 
-	describe, err := DescribeKvVolume(ctx, kvResource, stub)
+	var describe *Ydb_KeyValue.DescribeVolumeResult
+	err = retry.Do(ctx, h.authCreds.RetryPolicy, "describe kv volume", retry.Idempotent, func(ctx context.Context) (err error) {
+		describe, err = DescribeKvVolume(ctx, kvResource, stub)
+		return err
+	})
 	if err != nil {
 		return diag.Diagnostics{
 			diag.Diagnostic{
@@ -7,6 +7,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/ydb-platform/ydb-go-genproto/draft/Ydb_KeyValue_V1"
 	"github.com/ydb-platform/ydb-go-genproto/draft/protos/Ydb_KeyValue"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/retry"
 )
 
 const success = "SUCCESS"
@@ -30,7 +32,7 @@ func CreateKvVolume(ctx context.Context, kvResource *Resource, stub Ydb_KeyValue
 		return fmt.Errorf("create_volume problem: %w", err)
 	}
 	if opResp.Operation.Status.String() != success {
-		return fmt.Errorf("create operation code not success: %s, %v", opResp.Operation.Status.String(), opResp.Operation.Issues)
+		return fmt.Errorf("create operation code not success: %w", &retry.StatusError{Status: opResp.Operation.Status, Issues: opResp.Operation.Issues})
 	}
 
 	return nil
@@ -54,7 +56,7 @@ func DescribeKvVolume(ctx context.Context, kvResource *Resource, stub Ydb_KeyVal
 			return nil, fmt.Errorf("unmarshal_to problem: %w", err)
 		}
 	} else {
-		return nil, fmt.Errorf("describe operation code not success: %s, %v", opResp.Operation.Status.String(), opResp.Operation.Issues)
+		return nil, fmt.Errorf("describe operation code not success: %w", &retry.StatusError{Status: opResp.Operation.Status, Issues: opResp.Operation.Issues})
 	}
 	return result, nil
 }
@@ -87,7 +89,7 @@ func AlterKvVolume(ctx context.Context, d *schema.ResourceData, kvResource *Reso
 		if d.HasChange("storage_config") {
 			_ = d.Set("storage_config", oldval)
 		}
-		return fmt.Errorf("alter operation code not success: %s, %v", opResp.Operation.Status.String(), opResp.Operation.Issues)
+		return fmt.Errorf("alter operation code not success: %w", &retry.StatusError{Status: opResp.Operation.Status, Issues: opResp.Operation.Issues})
 	}
 	if d.HasChange("storage_config") {
 		_ = d.Set("storage_config", newval)
@@ -106,7 +108,7 @@ func DropKvVolume(ctx context.Context, kvResource *Resource, stub Ydb_KeyValue_V
 	}
 
 	if opResp.Operation.Status.String() != success {
-		return fmt.Errorf("drop operation code not success: %s, %v", opResp.Operation.Status.String(), opResp.Operation.Issues)
+		return fmt.Errorf("drop operation code not success: %w", &retry.StatusError{Status: opResp.Operation.Status, Issues: opResp.Operation.Issues})
 	}
 	return nil
 }
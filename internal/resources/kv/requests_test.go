@@ -15,6 +15,7 @@ import (
 
 	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
 	kv_mock "github.com/ydb-platform/terraform-provider-ydb/internal/resources/kv/mocks"
+	"github.com/ydb-platform/terraform-provider-ydb/internal/retry"
 )
 
 type mockBehaviorCreate func(
@@ -101,7 +102,7 @@ func TestCreateKvVolume(t *testing.T) {
 			mockBehavior: func(mockClient *kv_mock.MockKeyValueServiceClient, req *Ydb_KeyValue.CreateVolumeRequest, expectedResponse *Ydb_KeyValue.CreateVolumeResponse) {
 				mockClient.EXPECT().CreateVolume(gomock.Any(), req).Return(expectedResponse, nil)
 			},
-			expectedError: fmt.Errorf("create operation code not success: INTERNAL_ERROR, []"),
+			expectedError: fmt.Errorf("create operation code not success: %w", &retry.StatusError{Status: Ydb.StatusIds_INTERNAL_ERROR}),
 		},
 	}
 
@@ -227,7 +228,7 @@ func TestDescribeKvVolume(t *testing.T) {
 				mockClient.EXPECT().DescribeVolume(gomock.Any(), req).Return(expectedResponse, nil)
 			},
 			expectedResult: nil,
-			expectedError:  fmt.Errorf("describe operation code not success: PRECONDITION_FAILED, []"),
+			expectedError:  fmt.Errorf("describe operation code not success: %w", &retry.StatusError{Status: Ydb.StatusIds_PRECONDITION_FAILED}),
 		},
 	}
 
@@ -383,7 +384,7 @@ func TestAlterKvVolume(t *testing.T) {
 			mockBehavior: func(mockClient *kv_mock.MockKeyValueServiceClient, req *Ydb_KeyValue.AlterVolumeRequest, expectedResponse *Ydb_KeyValue.AlterVolumeResponse) {
 				mockClient.EXPECT().AlterVolume(gomock.Any(), req).Return(expectedResponse, nil)
 			},
-			expectedError: fmt.Errorf("alter operation code not success: ABORTED, []"),
+			expectedError: fmt.Errorf("alter operation code not success: %w", &retry.StatusError{Status: Ydb.StatusIds_ABORTED}),
 			schema:        dn,
 		},
 		{
@@ -397,7 +398,7 @@ func TestAlterKvVolume(t *testing.T) {
 			mockBehavior: func(mockClient *kv_mock.MockKeyValueServiceClient, req *Ydb_KeyValue.AlterVolumeRequest, expectedResponse *Ydb_KeyValue.AlterVolumeResponse) {
 				mockClient.EXPECT().AlterVolume(gomock.Any(), req).Return(expectedResponse, nil)
 			},
-			expectedError: fmt.Errorf("alter operation code not success: ABORTED, []"),
+			expectedError: fmt.Errorf("alter operation code not success: %w", &retry.StatusError{Status: Ydb.StatusIds_ABORTED}),
 			schema:        d,
 		},
 	}
@@ -486,7 +487,7 @@ func TestDropKvVolume(t *testing.T) {
 			mockBehavior: func(mockClient *kv_mock.MockKeyValueServiceClient, req *Ydb_KeyValue.DropVolumeRequest, expectedResponse *Ydb_KeyValue.DropVolumeResponse) {
 				mockClient.EXPECT().DropVolume(gomock.Any(), req).Return(expectedResponse, nil)
 			},
-			expectedError: fmt.Errorf("drop operation code not success: PRECONDITION_FAILED, []"),
+			expectedError: fmt.Errorf("drop operation code not success: %w", &retry.StatusError{Status: Ydb.StatusIds_PRECONDITION_FAILED}),
 		},
 	}
 
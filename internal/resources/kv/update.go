@@ -8,6 +8,7 @@ import (
 
 	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
 	"github.com/ydb-platform/terraform-provider-ydb/internal/kv"
+	"github.com/ydb-platform/terraform-provider-ydb/internal/retry"
 	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
 )
 
@@ -20,6 +21,7 @@ func (h *handler) Update(ctx context.Context, d *schema.ResourceData, cfg interf
 	conn, err := kv.CreateDBConnection(ctx, kv.ClientParams{
 		DatabaseEndpoint: kvResource.Endpoint,
 		UseTLS:           kvResource.UseTLS,
+		TLSConfig:        h.authCreds.TLSConfig,
 	})
 	if err != nil {
 		return diag.Diagnostics{
@@ -52,7 +54,9 @@ func (h *handler) Update(ctx context.Context, d *schema.ResourceData, cfg interf
 		},
 	}, conn)
 
-	err = AlterKvVolume(ctx, d, kvResource, stub)
+	err = retry.Do(ctx, h.authCreds.RetryPolicy, "alter kv volume", retry.Idempotent, func(ctx context.Context) error {
+		return AlterKvVolume(ctx, d, kvResource, stub)
+	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -0,0 +1,75 @@
+package permissions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func (h *handler) Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connectionString := d.Get("connection_string").(string)
+	subject := d.Get("subject").(string)
+
+	_, database, _, err := helpers.ParseYDBDatabaseEndpoint(connectionString)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	fullPath := fullObjectPath(database, d.Get("path").(string))
+
+	if diags := h.apply(ctx, d, connectionString, fullPath, nil); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(connectionString + "?path=" + entityPath(helpers.RelativizeYDBCatalogPath(database, fullPath), subject))
+
+	return h.Read(ctx, d, meta)
+}
+
+// apply grants and revokes permissions of the subject on fullPath and changes the owner if needed.
+// previous is the permission set Terraform declared before this apply.
+func (h *handler) apply(ctx context.Context, d *schema.ResourceData, connectionString, fullPath string, previous []string) diag.Diagnostics {
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: connectionString,
+		AuthCreds:        h.authCreds,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: "failed to initialize DB connection", Detail: err.Error()},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	entry, err := db.Scheme().DescribePath(ctx, fullPath)
+	if err != nil {
+		return diag.Errorf("failed to describe path %q: %s", fullPath, err)
+	}
+
+	subject := d.Get("subject").(string)
+	current := subjectPermissions(entry, subject)
+	grant, revoke := planChanges(d.Get("mode").(string), current, expandPermissions(d), previous)
+
+	var owner string
+	if v := d.Get("owner").(string); v != "" && v != entry.Owner {
+		owner = v
+	}
+
+	opts := modifyOptions(subject, grant, revoke, owner)
+	if len(opts) == 0 {
+		return nil
+	}
+	err = db.Retry(ctx, "modify permissions", tbl.Idempotent, func(ctx context.Context) error {
+		return db.Scheme().ModifyPermissions(ctx, fullPath, opts...)
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: "failed to modify permissions of " + fullPath, Detail: err.Error()},
+		}
+	}
+	return nil
+}
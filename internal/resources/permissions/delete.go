@@ -0,0 +1,60 @@
+package permissions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ydb "github.com/ydb-platform/ydb-go-sdk/v3"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+// Delete revokes the permissions managed by the resource: all permissions of the subject in
+// authoritative mode, the declared ones in additive mode. The owner is left unchanged.
+func (h *handler) Delete(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	entity, err := helpers.ParseYDBEntityID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	relPath, subject, err := parseEntityPath(entity.GetEntityPath())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	fullPath := fullObjectPath(entity.GetDatabasePath(), relPath)
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: entity.PrepareFullYDBEndpoint(),
+		AuthCreds:        h.authCreds,
+	})
+	if err != nil {
+		return diag.Errorf("failed to initialize table client: %s", err)
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	entry, err := db.Scheme().DescribePath(ctx, fullPath)
+	if err != nil {
+		if ydb.IsOperationErrorSchemeError(err) {
+			return nil
+		}
+		return diag.Errorf("failed to describe path %q: %s", fullPath, err)
+	}
+
+	_, revoke := planChanges(d.Get("mode").(string), subjectPermissions(entry, subject), nil, expandPermissions(d))
+	if len(revoke) == 0 {
+		return nil
+	}
+	err = db.Retry(ctx, "revoke permissions", tbl.Idempotent, func(ctx context.Context) error {
+		return db.Scheme().ModifyPermissions(ctx, fullPath, modifyOptions(subject, nil, revoke, "")...)
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: "failed to revoke permissions on " + fullPath, Detail: err.Error()},
+		}
+	}
+
+	return nil
+}
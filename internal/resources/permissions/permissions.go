@@ -0,0 +1,145 @@
+package permissions
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+)
+
+const (
+	ModeAuthoritative = "authoritative"
+	ModeAdditive      = "additive"
+)
+
+// subjectSeparator separates the object path and the subject in the `?path=` part of the resource
+// id and in import ids (`path#subject`).
+const subjectSeparator = "#"
+
+func entityPath(relPath, subject string) string {
+	return relPath + subjectSeparator + subject
+}
+
+func parseEntityPath(path string) (relPath, subject string, err error) {
+	i := strings.LastIndex(path, subjectSeparator)
+	if i < 0 || i == len(path)-1 {
+		return "", "", fmt.Errorf("failed to parse permissions id %q: expected <path>#<subject>", path)
+	}
+	return strings.Trim(path[:i], "/"), path[i+1:], nil
+}
+
+// subjectPermissions returns the sorted names of permissions granted to subject on the object
+// itself, merging all ACEs of the subject and skipping inherited ones.
+func subjectPermissions(entry scheme.Entry, subject string) []string {
+	seen := make(map[string]struct{})
+	for _, p := range entry.Permissions {
+		if p.Subject != subject {
+			continue
+		}
+		for _, name := range p.PermissionNames {
+			seen[name] = struct{}{}
+		}
+	}
+	return sortedKeys(seen)
+}
+
+// planChanges returns the permissions to grant and to revoke to move subject from current to
+// desired. In authoritative mode every current permission that is not desired is revoked; in
+// additive mode only the ones Terraform declared before (previous) are.
+func planChanges(mode string, current, desired, previous []string) (grant, revoke []string) {
+	currentSet := toSet(current)
+	desiredSet := toSet(desired)
+
+	for _, name := range desired {
+		if _, ok := currentSet[name]; !ok {
+			grant = append(grant, name)
+		}
+	}
+
+	candidates := previous
+	if mode == ModeAuthoritative {
+		candidates = current
+	}
+	for _, name := range candidates {
+		_, isCurrent := currentSet[name]
+		_, isDesired := desiredSet[name]
+		if isCurrent && !isDesired {
+			revoke = append(revoke, name)
+		}
+	}
+	sort.Strings(grant)
+	sort.Strings(revoke)
+	return grant, revoke
+}
+
+// stateValue is the permission set stored in state: all current permissions in authoritative mode,
+// so that grants made outside Terraform show up as drift, and the declared ones that are still
+// granted in additive mode.
+func stateValue(mode string, current, desired []string) []string {
+	if mode == ModeAuthoritative {
+		return current
+	}
+	currentSet := toSet(current)
+	var res []string
+	for _, name := range desired {
+		if _, ok := currentSet[name]; ok {
+			res = append(res, name)
+		}
+	}
+	sort.Strings(res)
+	return res
+}
+
+func modifyOptions(subject string, grant, revoke []string, owner string) []scheme.PermissionsOption {
+	var opts []scheme.PermissionsOption
+	if len(revoke) > 0 {
+		opts = append(opts, scheme.WithRevokePermissions(scheme.Permissions{Subject: subject, PermissionNames: revoke}))
+	}
+	if len(grant) > 0 {
+		opts = append(opts, scheme.WithGrantPermissions(scheme.Permissions{Subject: subject, PermissionNames: grant}))
+	}
+	if owner != "" {
+		opts = append(opts, scheme.WithChangeOwner(owner))
+	}
+	return opts
+}
+
+// fullObjectPath resolves the configured path, relative to the database root or absolute, into
+// the absolute catalog path.
+func fullObjectPath(database, path string) string {
+	return helpers.JoinYDBCatalogPath(database, path)
+}
+
+func expandPermissions(d *schema.ResourceData) []string {
+	return sortedSet(d.Get("permissions").(*schema.Set))
+}
+
+func sortedSet(s *schema.Set) []string {
+	res := make([]string, 0, s.Len())
+	for _, v := range s.List() {
+		res = append(res, v.(string))
+	}
+	sort.Strings(res)
+	return res
+}
+
+func toSet(names []string) map[string]struct{} {
+	res := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		res[name] = struct{}{}
+	}
+	return res
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	res := make([]string, 0, len(m))
+	for k := range m {
+		res = append(res, k)
+	}
+	sort.Strings(res)
+	return res
+}
@@ -0,0 +1,106 @@
+package permissions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
+)
+
+func TestPlanChanges(t *testing.T) {
+	testData := []struct {
+		testName       string
+		mode           string
+		current        []string
+		desired        []string
+		previous       []string
+		expectedGrant  []string
+		expectedRevoke []string
+	}{
+		{
+			testName:      "grant missing",
+			mode:          ModeAuthoritative,
+			current:       []string{"ydb.generic.read"},
+			desired:       []string{"ydb.generic.read", "ydb.generic.write"},
+			expectedGrant: []string{"ydb.generic.write"},
+		},
+		{
+			testName:       "authoritative revokes undeclared",
+			mode:           ModeAuthoritative,
+			current:        []string{"ydb.generic.read", "ydb.granular.erase_row"},
+			desired:        []string{"ydb.generic.read"},
+			expectedRevoke: []string{"ydb.granular.erase_row"},
+		},
+		{
+			testName: "additive keeps undeclared",
+			mode:     ModeAdditive,
+			current:  []string{"ydb.generic.read", "ydb.granular.erase_row"},
+			desired:  []string{"ydb.generic.read"},
+		},
+		{
+			testName:       "additive revokes previously declared",
+			mode:           ModeAdditive,
+			current:        []string{"ydb.generic.read", "ydb.generic.write", "ydb.granular.erase_row"},
+			desired:        []string{"ydb.generic.read", "ydb.granular.select_row"},
+			previous:       []string{"ydb.generic.read", "ydb.generic.write"},
+			expectedGrant:  []string{"ydb.granular.select_row"},
+			expectedRevoke: []string{"ydb.generic.write"},
+		},
+		{
+			testName:       "delete in authoritative mode revokes everything",
+			mode:           ModeAuthoritative,
+			current:        []string{"ydb.generic.read", "ydb.generic.write"},
+			previous:       []string{"ydb.generic.read"},
+			expectedRevoke: []string{"ydb.generic.read", "ydb.generic.write"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.testName, func(t *testing.T) {
+			grant, revoke := planChanges(v.mode, v.current, v.desired, v.previous)
+			assert.Equal(t, v.expectedGrant, grant)
+			assert.Equal(t, v.expectedRevoke, revoke)
+		})
+	}
+}
+
+func TestStateValue(t *testing.T) {
+	current := []string{"ydb.generic.read", "ydb.granular.erase_row"}
+	desired := []string{"ydb.generic.read", "ydb.generic.write"}
+
+	assert.Equal(t, current, stateValue(ModeAuthoritative, current, desired))
+	assert.Equal(t, []string{"ydb.generic.read"}, stateValue(ModeAdditive, current, desired))
+}
+
+func TestSubjectPermissions(t *testing.T) {
+	entry := scheme.Entry{
+		Permissions: []scheme.Permissions{
+			{Subject: "alice", PermissionNames: []string{"ydb.generic.write"}},
+			{Subject: "bob", PermissionNames: []string{"ydb.generic.full"}},
+			{Subject: "alice", PermissionNames: []string{"ydb.generic.read", "ydb.generic.write"}},
+		},
+		EffectivePermissions: []scheme.Permissions{
+			{Subject: "alice", PermissionNames: []string{"ydb.generic.manage"}},
+		},
+	}
+	assert.Equal(t, []string{"ydb.generic.read", "ydb.generic.write"}, subjectPermissions(entry, "alice"))
+	assert.Empty(t, subjectPermissions(entry, "carol"))
+}
+
+func TestParseEntityPath(t *testing.T) {
+	relPath, subject, err := parseEntityPath(entityPath("folder/table", "admins@builtin"))
+	require.NoError(t, err)
+	assert.Equal(t, "folder/table", relPath)
+	assert.Equal(t, "admins@builtin", subject)
+
+	relPath, subject, err = parseEntityPath("/#alice")
+	require.NoError(t, err)
+	assert.Equal(t, "", relPath)
+	assert.Equal(t, "alice", subject)
+
+	for _, path := range []string{"folder/table", "folder/table#"} {
+		_, _, err = parseEntityPath(path)
+		assert.Error(t, err, path)
+	}
+}
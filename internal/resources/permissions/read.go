@@ -0,0 +1,68 @@
+package permissions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ydb "github.com/ydb-platform/ydb-go-sdk/v3"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func (h *handler) Read(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	entity, err := helpers.ParseYDBEntityID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	relPath, subject, err := parseEntityPath(entity.GetEntityPath())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	database := entity.GetDatabasePath()
+	fullPath := fullObjectPath(database, relPath)
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: entity.PrepareFullYDBEndpoint(),
+		AuthCreds:        h.authCreds,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: "failed to initialize table client", Detail: err.Error()},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	entry, err := db.Scheme().DescribePath(ctx, fullPath)
+	if err != nil {
+		if ydb.IsOperationErrorSchemeError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to describe path %q: %s", fullPath, err)
+	}
+
+	mode := d.Get("mode").(string)
+	if mode == "" {
+		mode = ModeAuthoritative
+	}
+	current := subjectPermissions(entry, subject)
+
+	// Keep the configured spelling (relative or absolute) of the same path.
+	if p := d.Get("path").(string); p == "" || fullObjectPath(database, p) != fullPath {
+		if relPath == "" {
+			relPath = "/"
+		}
+		_ = d.Set("path", relPath)
+	}
+	_ = d.Set("connection_string", entity.PrepareFullYDBEndpoint())
+	_ = d.Set("subject", subject)
+	_ = d.Set("mode", mode)
+	_ = d.Set("permissions", stateValue(mode, current, expandPermissions(d)))
+	_ = d.Set("owner", entry.Owner)
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package permissions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+)
+
+func (h *handler) Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	entity, err := helpers.ParseYDBEntityID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	relPath, _, err := parseEntityPath(entity.GetEntityPath())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	oldPermissions, _ := d.GetChange("permissions")
+	fullPath := fullObjectPath(entity.GetDatabasePath(), relPath)
+	if diags := h.apply(ctx, d, entity.PrepareFullYDBEndpoint(), fullPath, sortedSet(oldPermissions.(*schema.Set))); diags.HasError() {
+		return diags
+	}
+
+	return h.Read(ctx, d, meta)
+}
@@ -0,0 +1,63 @@
+package resourcepool
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ydb "github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func (h *handler) Read(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	entity, err := helpers.ParseYDBEntityID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: entity.PrepareFullYDBEndpoint(),
+		AuthCreds:        h.authCreds,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: "failed to initialize table client", Detail: err.Error()},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	name := entity.GetEntityPath()
+	row, err := db.Query().QueryRow(ctx, selectResourcePoolQuery,
+		query.WithParameters(ydb.ParamsBuilder().Param("$name").Text(name).Build()),
+	)
+	if errors.Is(err, query.ErrNoRows) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("failed to read resource pool %q from .sys/resource_pools: %s", name, err)
+	}
+	values := make([]interface{}, len(settings))
+	dst := make([]query.NamedDestination, len(settings))
+	for i, s := range settings {
+		values[i] = s.scanValue()
+		dst[i] = query.Named(s.column, values[i])
+	}
+	if err = row.ScanNamed(dst...); err != nil {
+		return diag.Errorf("failed to scan resource pool %q: %s", name, err)
+	}
+
+	_ = d.Set("connection_string", entity.PrepareFullYDBEndpoint())
+	_ = d.Set("name", name)
+	for i, s := range settings {
+		_ = d.Set(s.attr, s.stateValue(values[i]))
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package resourcepool
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+)
+
+// NoLimit is the value YDB uses for resource pool limits that are not set.
+const NoLimit = -1
+
+// setting describes a resource pool limit: its Terraform attribute, YQL option and
+// `.sys/resource_pools` column.
+type setting struct {
+	attr    string
+	option  string
+	column  string
+	isFloat bool
+}
+
+// settings lists all resource pool limits managed by the provider.
+var settings = []setting{
+	{attr: "concurrent_query_limit", option: "CONCURRENT_QUERY_LIMIT", column: "ConcurrentQueryLimit"},
+	{attr: "queue_size", option: "QUEUE_SIZE", column: "QueueSize"},
+	{attr: "database_load_cpu_threshold", option: "DATABASE_LOAD_CPU_THRESHOLD", column: "DatabaseLoadCpuThreshold", isFloat: true},
+	{attr: "resource_weight", option: "RESOURCE_WEIGHT", column: "ResourceWeight", isFloat: true},
+	{attr: "total_cpu_limit_percent_per_node", option: "TOTAL_CPU_LIMIT_PERCENT_PER_NODE", column: "TotalCpuLimitPercentPerNode", isFloat: true},
+	{attr: "query_cpu_limit_percent_per_node", option: "QUERY_CPU_LIMIT_PERCENT_PER_NODE", column: "QueryCpuLimitPercentPerNode", isFloat: true},
+	{attr: "query_memory_limit_percent_per_node", option: "QUERY_MEMORY_LIMIT_PERCENT_PER_NODE", column: "QueryMemoryLimitPercentPerNode", isFloat: true},
+}
+
+// yqlSetting formats the configured value of s, NoLimit resets it.
+func (s setting) yqlSetting(d *schema.ResourceData) helpers.YQLSetting {
+	if s.isFloat {
+		v := d.Get(s.attr).(float64)
+		if v == NoLimit {
+			return helpers.YQLResetSetting(s.option)
+		}
+		return helpers.YQLFloatSetting(s.option, v)
+	}
+	v := d.Get(s.attr).(int)
+	if v == NoLimit {
+		return helpers.YQLResetSetting(s.option)
+	}
+	return helpers.YQLIntSetting(s.option, v)
+}
+
+func createSettings(d *schema.ResourceData) []helpers.YQLSetting {
+	res := make([]helpers.YQLSetting, 0, len(settings))
+	for _, s := range settings {
+		res = append(res, s.yqlSetting(d))
+	}
+	return res
+}
+
+func changedSettings(d *schema.ResourceData) []helpers.YQLSetting {
+	var res []helpers.YQLSetting
+	for _, s := range settings {
+		if d.HasChange(s.attr) {
+			res = append(res, s.yqlSetting(d))
+		}
+	}
+	return res
+}
+
+// scanValue returns the destination for s in selectResourcePoolQuery results.
+func (s setting) scanValue() interface{} {
+	if s.isFloat {
+		return new(float64)
+	}
+	return new(int64)
+}
+
+// stateValue converts a value scanned with scanValue to the Terraform attribute type.
+func (s setting) stateValue(v interface{}) interface{} {
+	if s.isFloat {
+		return *v.(*float64)
+	}
+	return int(*v.(*int64))
+}
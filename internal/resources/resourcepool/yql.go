@@ -0,0 +1,37 @@
+package resourcepool
+
+import (
+	"strings"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+)
+
+func prepareCreateResourcePoolQuery(name string, opts []helpers.YQLSetting) string {
+	with := helpers.PrepareYQLWithClause(opts)
+	if with == "" {
+		// The WITH clause is mandatory, an explicit default keeps the pool unlimited.
+		with = helpers.PrepareYQLWithClause([]helpers.YQLSetting{helpers.YQLIntSetting("CONCURRENT_QUERY_LIMIT", NoLimit)})
+	}
+	return "CREATE RESOURCE POOL `" + helpers.EscapeYQLIdentifier(name) + "`" + with
+}
+
+func prepareAlterResourcePoolQuery(name string, opts []helpers.YQLSetting) string {
+	return "ALTER RESOURCE POOL `" + helpers.EscapeYQLIdentifier(name) + "` " + helpers.PrepareYQLSetResetClause(opts)
+}
+
+func prepareDropResourcePoolQuery(name string) string {
+	return "DROP RESOURCE POOL `" + helpers.EscapeYQLIdentifier(name) + "`"
+}
+
+// selectResourcePoolQuery reads all settings of a pool, missing limits are returned as NoLimit.
+var selectResourcePoolQuery = func() string {
+	columns := make([]string, 0, len(settings))
+	for _, s := range settings {
+		typ, noLimit := "Int64", "-1l"
+		if s.isFloat {
+			typ, noLimit = "Double", "-1.0"
+		}
+		columns = append(columns, "COALESCE(CAST("+s.column+" AS "+typ+"), "+noLimit+") AS "+s.column)
+	}
+	return "SELECT " + strings.Join(columns, ", ") + " FROM `.sys/resource_pools` WHERE Name = $name"
+}()
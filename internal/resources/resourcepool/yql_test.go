@@ -0,0 +1,57 @@
+package resourcepool
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+)
+
+func TestPrepareResourcePoolQueries(t *testing.T) {
+	testData := []struct {
+		testName string
+		got      string
+		expected string
+	}{
+		{
+			testName: "create with limits",
+			got: prepareCreateResourcePoolQuery("olap", []helpers.YQLSetting{
+				helpers.YQLIntSetting("CONCURRENT_QUERY_LIMIT", 20),
+				helpers.YQLResetSetting("QUEUE_SIZE"),
+				helpers.YQLFloatSetting("TOTAL_CPU_LIMIT_PERCENT_PER_NODE", 50),
+			}),
+			expected: "CREATE RESOURCE POOL `olap` WITH (CONCURRENT_QUERY_LIMIT = 20, TOTAL_CPU_LIMIT_PERCENT_PER_NODE = 50)",
+		},
+		{
+			testName: "create without limits",
+			got:      prepareCreateResourcePoolQuery("olap", []helpers.YQLSetting{helpers.YQLResetSetting("QUEUE_SIZE")}),
+			expected: "CREATE RESOURCE POOL `olap` WITH (CONCURRENT_QUERY_LIMIT = -1)",
+		},
+		{
+			testName: "alter",
+			got: prepareAlterResourcePoolQuery("olap", []helpers.YQLSetting{
+				helpers.YQLFloatSetting("QUERY_MEMORY_LIMIT_PERCENT_PER_NODE", 12.5),
+				helpers.YQLResetSetting("CONCURRENT_QUERY_LIMIT"),
+			}),
+			expected: "ALTER RESOURCE POOL `olap` SET (QUERY_MEMORY_LIMIT_PERCENT_PER_NODE = 12.5), RESET (CONCURRENT_QUERY_LIMIT)",
+		},
+		{
+			testName: "drop",
+			got:      prepareDropResourcePoolQuery("olap"),
+			expected: "DROP RESOURCE POOL `olap`",
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.testName, func(t *testing.T) {
+			assert.Equal(t, v.expected, v.got)
+		})
+	}
+}
+
+func TestSelectResourcePoolQuery(t *testing.T) {
+	assert.Contains(t, selectResourcePoolQuery, "COALESCE(CAST(ConcurrentQueryLimit AS Int64), -1l) AS ConcurrentQueryLimit, ")
+	assert.Contains(t, selectResourcePoolQuery, "COALESCE(CAST(TotalCpuLimitPercentPerNode AS Double), -1.0) AS TotalCpuLimitPercentPerNode, ")
+	assert.Contains(t, selectResourcePoolQuery, " FROM `.sys/resource_pools` WHERE Name = $name")
+}
@@ -0,0 +1,67 @@
+package resourcepoolclassifier
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ydb "github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func (h *handler) Read(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	entity, err := helpers.ParseYDBEntityID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: entity.PrepareFullYDBEndpoint(),
+		AuthCreds:        h.authCreds,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: "failed to initialize table client", Detail: err.Error()},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	name := entity.GetEntityPath()
+	row, err := db.Query().QueryRow(ctx, selectClassifierQuery,
+		query.WithParameters(ydb.ParamsBuilder().Param("$name").Text(name).Build()),
+	)
+	if errors.Is(err, query.ErrNoRows) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("failed to read resource pool classifier %q from .sys/resource_pool_classifiers: %s", name, err)
+	}
+	var (
+		rank         int64
+		memberName   string
+		resourcePool string
+	)
+	err = row.ScanNamed(
+		query.Named("Rank", &rank),
+		query.Named("MemberName", &memberName),
+		query.Named("ResourcePool", &resourcePool),
+	)
+	if err != nil {
+		return diag.Errorf("failed to scan resource pool classifier %q: %s", name, err)
+	}
+
+	_ = d.Set("connection_string", entity.PrepareFullYDBEndpoint())
+	_ = d.Set("name", name)
+	_ = d.Set("rank", int(rank))
+	_ = d.Set("member_name", memberName)
+	_ = d.Set("resource_pool", resourcePool)
+
+	return nil
+}
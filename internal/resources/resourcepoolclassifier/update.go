@@ -0,0 +1,50 @@
+package resourcepoolclassifier
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func (h *handler) Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	entity, err := helpers.ParseYDBEntityID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var attrs []string
+	for _, attr := range []string{"resource_pool", "member_name", "rank"} {
+		if d.HasChange(attr) {
+			attrs = append(attrs, attr)
+		}
+	}
+	if len(attrs) == 0 {
+		return h.Read(ctx, d, meta)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: entity.PrepareFullYDBEndpoint(),
+		AuthCreds:        h.authCreds,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: "failed to initialize table client", Detail: err.Error()},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	err = db.ExecQuery(ctx, prepareAlterClassifierQuery(entity.GetEntityPath(), classifierSettings(d, attrs...)), tbl.Idempotent)
+	if err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: "failed to execute query ALTER RESOURCE POOL CLASSIFIER ...", Detail: err.Error()},
+		}
+	}
+
+	return h.Read(ctx, d, meta)
+}
@@ -0,0 +1,49 @@
+package resourcepoolclassifier
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+)
+
+const selectClassifierQuery = "SELECT COALESCE(CAST(Rank AS Int64), 0l) AS Rank, " +
+	"COALESCE(CAST(MemberName AS Utf8), \"\"u) AS MemberName, " +
+	"COALESCE(CAST(ResourcePool AS Utf8), \"\"u) AS ResourcePool " +
+	"FROM `.sys/resource_pool_classifiers` WHERE Name = $name"
+
+// classifierSettings returns settings of attrs. Unset member_name and rank are reset, so YDB
+// matches all users and assigns the rank itself.
+func classifierSettings(d *schema.ResourceData, attrs ...string) []helpers.YQLSetting {
+	res := make([]helpers.YQLSetting, 0, len(attrs))
+	for _, attr := range attrs {
+		switch attr {
+		case "resource_pool":
+			res = append(res, helpers.YQLStringSetting("RESOURCE_POOL", d.Get(attr).(string)))
+		case "member_name":
+			if v := d.Get(attr).(string); v != "" {
+				res = append(res, helpers.YQLStringSetting("MEMBER_NAME", v))
+			} else {
+				res = append(res, helpers.YQLResetSetting("MEMBER_NAME"))
+			}
+		case "rank":
+			if !d.GetRawConfig().GetAttr(attr).IsNull() {
+				res = append(res, helpers.YQLIntSetting("RANK", d.Get(attr).(int)))
+			} else {
+				res = append(res, helpers.YQLResetSetting("RANK"))
+			}
+		}
+	}
+	return res
+}
+
+func prepareCreateClassifierQuery(name string, opts []helpers.YQLSetting) string {
+	return "CREATE RESOURCE POOL CLASSIFIER `" + helpers.EscapeYQLIdentifier(name) + "`" + helpers.PrepareYQLWithClause(opts)
+}
+
+func prepareAlterClassifierQuery(name string, opts []helpers.YQLSetting) string {
+	return "ALTER RESOURCE POOL CLASSIFIER `" + helpers.EscapeYQLIdentifier(name) + "` " + helpers.PrepareYQLSetResetClause(opts)
+}
+
+func prepareDropClassifierQuery(name string) string {
+	return "DROP RESOURCE POOL CLASSIFIER `" + helpers.EscapeYQLIdentifier(name) + "`"
+}
@@ -0,0 +1,46 @@
+package resourcepoolclassifier
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+)
+
+func TestPrepareClassifierQueries(t *testing.T) {
+	testData := []struct {
+		testName string
+		got      string
+		expected string
+	}{
+		{
+			testName: "create",
+			got: prepareCreateClassifierQuery("analysts", []helpers.YQLSetting{
+				helpers.YQLStringSetting("RESOURCE_POOL", "olap"),
+				helpers.YQLStringSetting("MEMBER_NAME", "analysts@as"),
+				helpers.YQLResetSetting("RANK"),
+			}),
+			expected: "CREATE RESOURCE POOL CLASSIFIER `analysts` WITH (RESOURCE_POOL = 'olap', MEMBER_NAME = 'analysts@as')",
+		},
+		{
+			testName: "alter",
+			got: prepareAlterClassifierQuery("analysts", []helpers.YQLSetting{
+				helpers.YQLIntSetting("RANK", 100),
+				helpers.YQLResetSetting("MEMBER_NAME"),
+			}),
+			expected: "ALTER RESOURCE POOL CLASSIFIER `analysts` SET (RANK = 100), RESET (MEMBER_NAME)",
+		},
+		{
+			testName: "drop",
+			got:      prepareDropClassifierQuery("analysts"),
+			expected: "DROP RESOURCE POOL CLASSIFIER `analysts`",
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.testName, func(t *testing.T) {
+			assert.Equal(t, v.expected, v.got)
+		})
+	}
+}
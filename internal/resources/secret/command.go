@@ -1,12 +1,12 @@
 package secret
 
 import (
-	"bytes"
 	"context"
 	"fmt"
-	"os/exec"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
 )
 
 func resolveSecretValue(ctx context.Context, d *schema.ResourceData) (string, error) {
@@ -20,34 +20,5 @@ func resolveSecretValue(ctx context.Context, d *schema.ResourceData) (string, er
 	}
 
 	cmdMap := cmdList[0].(map[string]interface{})
-	return runCommand(ctx, cmdMap)
-}
-
-func runCommand(ctx context.Context, cmdMap map[string]interface{}) (string, error) {
-	path := cmdMap["path"].(string)
-
-	var args []string
-	if rawArgs, ok := cmdMap["args"].([]interface{}); ok {
-		for _, a := range rawArgs {
-			args = append(args, a.(string))
-		}
-	}
-
-	cmd := exec.CommandContext(ctx, path, args...)
-
-	if rawEnv, ok := cmdMap["env"].(map[string]interface{}); ok {
-		for k, v := range rawEnv {
-			cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", k, v.(string)))
-		}
-	}
-
-	var stdout, stderr bytes.Buffer
-	cmd.Stdout = &stdout
-	cmd.Stderr = &stderr
-
-	if err := cmd.Run(); err != nil {
-		return "", fmt.Errorf("command %q failed: %w\nstdout: %s\nstderr: %s", path, err, stdout.String(), stderr.String())
-	}
-
-	return stdout.String(), nil
+	return helpers.RunCommand(ctx, cmdMap)
 }
@@ -40,7 +40,7 @@ func (h *handler) Create(ctx context.Context, d *schema.ResourceData, meta inter
 	if inheritPermissions {
 		q = fmt.Sprintf("CREATE SECRET `%s` WITH (value = '%s', inherit_permissions = True)", escapedName, escapedValue)
 	}
-	err = db.Query().Exec(ctx, q)
+	err = db.ExecQuery(ctx, q, tbl.NonIdempotent)
 	if err != nil {
 		return diag.Diagnostics{
 			{Severity: diag.Error, Summary: "failed to executing `CREATE SECRET ...`", Detail: err.Error()},
@@ -29,7 +29,7 @@ func (h *handler) Delete(ctx context.Context, d *schema.ResourceData, _ interfac
 	}()
 
 	q := fmt.Sprintf("DROP SECRET `%s`", helpers.EscapeYQLIdentifier(entity.GetEntityPath()))
-	err = db.Query().Exec(ctx, q)
+	err = db.ExecQuery(ctx, q, tbl.NonIdempotent)
 	if err != nil {
 		return diag.Diagnostics{
 			{Severity: diag.Error, Summary: fmt.Sprintf("failed to execute query %q", q), Detail: err.Error()},
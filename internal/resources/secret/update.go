@@ -36,7 +36,7 @@ func (h *handler) Update(ctx context.Context, d *schema.ResourceData, meta inter
 	}()
 
 	q := fmt.Sprintf("ALTER SECRET `%s` WITH (value = '%s')", helpers.EscapeYQLIdentifier(entity.GetEntityPath()), helpers.EscapeYQLString(value))
-	err = db.Query().Exec(ctx, q)
+	err = db.ExecQuery(ctx, q, tbl.Idempotent)
 	if err != nil {
 		return diag.Diagnostics{
 			{Severity: diag.Error, Summary: "failed to execute query ALTER SECRET ...", Detail: err.Error()},
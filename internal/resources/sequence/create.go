@@ -0,0 +1,83 @@
+package sequence
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+// Create takes over the sequence YDB created for a serial column together with its table and
+// applies the configured parameters to it. restart_with restarts the sequence of an empty table
+// only: a table with rows is already in use, e.g. when the resource is replaced, and restarting
+// its sequence would hand out values present in the table again.
+func (h *handler) Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connectionString := d.Get("connection_string").(string)
+	tablePath := helpers.TrimPath(d.Get("table_path").(string))
+	column := d.Get("column").(string)
+
+	_, database, _, err := helpers.ParseYDBDatabaseEndpoint(connectionString)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tableFullPath := helpers.JoinYDBCatalogPath(database, tablePath)
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: connectionString,
+		AuthCreds:        h.authCreds,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: "failed to initialize DB connection", Detail: err.Error()},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	seq, err := describeColumnSequence(ctx, db, tableFullPath, column)
+	if err != nil {
+		return diag.Errorf("failed to describe table %q: %s", tableFullPath, err)
+	}
+	if seq == nil {
+		return diag.Errorf("column %q of table %q is not a serial column", column, tableFullPath)
+	}
+
+	opts := alterOptions{
+		StartWith:   configuredInt(d, "start_with"),
+		Increment:   configuredInt(d, "increment"),
+		RestartWith: configuredInt(d, "restart_with"),
+	}
+	var diags diag.Diagnostics
+	if opts.RestartWith != nil {
+		hasRows, err := tableHasRows(ctx, db, tableFullPath)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if hasRows {
+			opts.RestartWith = nil
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "sequence was not restarted",
+				Detail: fmt.Sprintf("table %q already has rows, so the sequence of column %q is taken over without RESTART WITH %d. "+
+					"Change restart_with to restart it.", tablePath, column, *configuredInt(d, "restart_with")),
+			})
+		}
+	}
+	if !opts.empty() {
+		err = db.ExecQuery(ctx, prepareAlterSequenceQuery(sequencePath(tableFullPath, column), opts), opts.idempotency())
+		if err != nil {
+			return diag.Diagnostics{
+				{Severity: diag.Error, Summary: "failed to execute query ALTER SEQUENCE ...", Detail: err.Error()},
+			}
+		}
+	}
+
+	d.SetId(connectionString + "?path=" + sequencePath(tablePath, column))
+
+	return append(diags, h.Read(ctx, d, meta)...)
+}
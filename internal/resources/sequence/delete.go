@@ -0,0 +1,14 @@
+package sequence
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Delete only forgets the sequence: it belongs to its serial column and is dropped together with
+// the table.
+func (h *handler) Delete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
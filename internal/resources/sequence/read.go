@@ -0,0 +1,62 @@
+package sequence
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func (h *handler) Read(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	entity, err := helpers.ParseYDBEntityID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tablePath, column, err := splitSequencePath(entity.GetEntityPath())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tableFullPath := helpers.JoinYDBCatalogPath(entity.GetDatabasePath(), tablePath)
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: entity.PrepareFullYDBEndpoint(),
+		AuthCreds:        h.authCreds,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: "failed to initialize table client", Detail: err.Error()},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	seq, err := describeColumnSequence(ctx, db, tableFullPath, column)
+	if err != nil {
+		if helpers.IsSchemeErrorStatus(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to describe table %q: %s", tableFullPath, err)
+	}
+	if seq == nil {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("connection_string", entity.PrepareFullYDBEndpoint())
+	_ = d.Set("table_path", tablePath)
+	_ = d.Set("column", column)
+	_ = d.Set("full_path", entity.GetFullEntityPath())
+	_ = d.Set("start_with", int(seq.GetStartValue()))
+	_ = d.Set("increment", int(seq.GetIncrement()))
+	_ = d.Set("min_value", int(seq.GetMinValue()))
+	_ = d.Set("max_value", int(seq.GetMaxValue()))
+	_ = d.Set("cache", int(seq.GetCache()))
+	_ = d.Set("cycle", seq.GetCycle())
+
+	return nil
+}
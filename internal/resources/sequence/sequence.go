@@ -0,0 +1,97 @@
+package sequence
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ydb-platform/ydb-go-genproto/Ydb_Table_V1"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	ydb "github.com/ydb-platform/ydb-go-sdk/v3"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/retry"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+// serialSequencePrefix is how YDB names the sequence of a serial column inside its table.
+const serialSequencePrefix = "_serial_column_"
+
+// sequencePath returns the path of the sequence of a serial column of the table at tablePath.
+func sequencePath(tablePath, column string) string {
+	return tablePath + "/" + serialSequencePrefix + column
+}
+
+// splitSequencePath is the inverse of sequencePath.
+func splitSequencePath(p string) (tablePath, column string, err error) {
+	name := path.Base(p)
+	if !strings.HasPrefix(name, serialSequencePrefix) {
+		return "", "", fmt.Errorf("%q is not a sequence of a serial column: expected <table>/%s<column>", p, serialSequencePrefix)
+	}
+	return path.Dir(p), strings.TrimPrefix(name, serialSequencePrefix), nil
+}
+
+// describeColumnSequence returns the sequence the column of the table at tableFullPath takes its
+// values from, or nil if there is no such serial column. The SDK table client drops sequence
+// descriptions, so the request goes to the table service directly.
+func describeColumnSequence(ctx context.Context, db *tbl.Driver, tableFullPath, column string) (*Ydb_Table.SequenceDescription, error) {
+	client := Ydb_Table_V1.NewTableServiceClient(ydb.GRPCConn(db.Driver))
+	result := &Ydb_Table.DescribeTableResult{}
+	err := db.Retry(ctx, "describe table", tbl.Idempotent, func(ctx context.Context) error {
+		resp, err := client.DescribeTable(ctx, &Ydb_Table.DescribeTableRequest{Path: tableFullPath})
+		if err != nil {
+			return fmt.Errorf("describe_table problem: %w", err)
+		}
+		op := resp.GetOperation()
+		if op.GetStatus() != Ydb.StatusIds_SUCCESS {
+			return fmt.Errorf("describe table operation code not success: %w", &retry.StatusError{Status: op.GetStatus(), Issues: op.GetIssues()})
+		}
+		if err := op.GetResult().UnmarshalTo(result); err != nil {
+			return fmt.Errorf("unmarshal_to problem: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range result.GetColumns() {
+		if c.GetName() == column {
+			return c.GetFromSequence(), nil
+		}
+	}
+	return nil, nil
+}
+
+// tableHasRows reports whether the table at tableFullPath has any rows.
+func tableHasRows(ctx context.Context, db *tbl.Driver, tableFullPath string) (bool, error) {
+	row, err := db.Query().QueryRow(ctx, prepareHasRowsQuery(tableFullPath))
+	if err != nil {
+		return false, fmt.Errorf("failed to read table %q: %w", tableFullPath, err)
+	}
+	var count uint64
+	if err := row.Scan(&count); err != nil {
+		return false, fmt.Errorf("failed to read table %q: %w", tableFullPath, err)
+	}
+	return count > 0, nil
+}
+
+// ValidateResourceDiffTableMove plans the move of the sequence to the table it is configured for
+// now, e.g. after the table is renamed: the sequence lives inside its table and moves with it.
+func ValidateResourceDiffTableMove(d *schema.ResourceDiff) error {
+	if d.Id() == "" || !d.HasChange("table_path") {
+		return nil
+	}
+	return d.SetNewComputed("full_path")
+}
+
+// configuredInt returns the value of an integer argument set in the configuration, nil if the
+// argument is omitted. Unlike GetOk it tells an explicit zero from an omitted argument.
+func configuredInt(d *schema.ResourceData, key string) *int {
+	if d.GetRawConfig().GetAttr(key).IsNull() {
+		return nil
+	}
+	v := d.Get(key).(int)
+	return &v
+}
@@ -0,0 +1,45 @@
+package sequence
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func TestSequencePath(t *testing.T) {
+	p := sequencePath("shop/orders", "id")
+	assert.Equal(t, "shop/orders/_serial_column_id", p)
+
+	tablePath, column, err := splitSequencePath(p)
+	require.NoError(t, err)
+	assert.Equal(t, "shop/orders", tablePath)
+	assert.Equal(t, "id", column)
+
+	_, _, err = splitSequencePath("shop/orders/id")
+	assert.Error(t, err)
+}
+
+func TestPrepareAlterSequenceQuery(t *testing.T) {
+	start, increment, restart := 100, 2, 0
+	assert.Equal(t,
+		"ALTER SEQUENCE `/Root/db/t/_serial_column_id` INCREMENT BY 2 START WITH 100 RESTART WITH 0",
+		prepareAlterSequenceQuery("/Root/db/t/_serial_column_id", alterOptions{StartWith: &start, Increment: &increment, RestartWith: &restart}),
+	)
+	assert.Equal(t,
+		"ALTER SEQUENCE `/Root/db/t/_serial_column_id` INCREMENT BY 2",
+		prepareAlterSequenceQuery("/Root/db/t/_serial_column_id", alterOptions{Increment: &increment}),
+	)
+	assert.True(t, alterOptions{}.empty())
+	assert.Equal(t, tbl.Idempotent, alterOptions{Increment: &increment}.idempotency())
+	assert.Equal(t, tbl.NonIdempotent, alterOptions{Increment: &increment, RestartWith: &restart}.idempotency())
+}
+
+func TestPrepareHasRowsQuery(t *testing.T) {
+	assert.Equal(t,
+		"SELECT COUNT(*) FROM (SELECT 1 FROM `/Root/db/t` LIMIT 1)",
+		prepareHasRowsQuery("/Root/db/t"),
+	)
+}
@@ -0,0 +1,76 @@
+package sequence
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+// Update follows the table of the sequence when table_path changes, e.g. after the table is
+// renamed: the sequence moves with its table and is adopted at the new path without a restart.
+// restart_with restarts the sequence only when it changes.
+func (h *handler) Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var opts alterOptions
+	if d.HasChange("start_with") {
+		opts.StartWith = configuredInt(d, "start_with")
+	}
+	if d.HasChange("increment") {
+		opts.Increment = configuredInt(d, "increment")
+	}
+	if d.HasChange("restart_with") {
+		opts.RestartWith = configuredInt(d, "restart_with")
+	}
+	moved := d.HasChange("table_path")
+	if opts.empty() && !moved {
+		return h.Read(ctx, d, meta)
+	}
+
+	entity, err := helpers.ParseYDBEntityID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: entity.PrepareFullYDBEndpoint(),
+		AuthCreds:        h.authCreds,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: "failed to initialize table client", Detail: err.Error()},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	fullPath := entity.GetFullEntityPath()
+	if moved {
+		tablePath := helpers.TrimPath(d.Get("table_path").(string))
+		column := d.Get("column").(string)
+		tableFullPath := helpers.JoinYDBCatalogPath(entity.GetDatabasePath(), tablePath)
+		seq, err := describeColumnSequence(ctx, db, tableFullPath, column)
+		if err != nil {
+			return diag.Errorf("failed to describe table %q: %s", tableFullPath, err)
+		}
+		if seq == nil {
+			return diag.Errorf("column %q of table %q is not a serial column", column, tableFullPath)
+		}
+		d.SetId(entity.PrepareFullYDBEndpoint() + "?path=" + sequencePath(tablePath, column))
+		fullPath = sequencePath(tableFullPath, column)
+	}
+
+	if !opts.empty() {
+		err = db.ExecQuery(ctx, prepareAlterSequenceQuery(fullPath, opts), opts.idempotency())
+		if err != nil {
+			return diag.Diagnostics{
+				{Severity: diag.Error, Summary: "failed to execute query ALTER SEQUENCE ...", Detail: err.Error()},
+			}
+		}
+	}
+
+	return h.Read(ctx, d, meta)
+}
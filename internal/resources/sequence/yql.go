@@ -0,0 +1,55 @@
+package sequence
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+// alterOptions are the clauses of ALTER SEQUENCE, nil fields are left unchanged.
+type alterOptions struct {
+	StartWith   *int
+	Increment   *int
+	RestartWith *int
+}
+
+func (o alterOptions) empty() bool {
+	return o.StartWith == nil && o.Increment == nil && o.RestartWith == nil
+}
+
+// idempotency tells whether the statement may be retried after an error that does not say if it
+// ran: a repeated RESTART would hand out the same values again.
+func (o alterOptions) idempotency() tbl.Idempotency {
+	if o.RestartWith != nil {
+		return tbl.NonIdempotent
+	}
+	return tbl.Idempotent
+}
+
+func prepareAlterSequenceQuery(fullPath string, o alterOptions) string {
+	var b strings.Builder
+	b.WriteString("ALTER SEQUENCE `")
+	b.WriteString(helpers.EscapeYQLIdentifier(fullPath))
+	b.WriteString("`")
+	if o.Increment != nil {
+		b.WriteString(" INCREMENT BY ")
+		b.WriteString(strconv.Itoa(*o.Increment))
+	}
+	if o.StartWith != nil {
+		b.WriteString(" START WITH ")
+		b.WriteString(strconv.Itoa(*o.StartWith))
+	}
+	if o.RestartWith != nil {
+		b.WriteString(" RESTART WITH ")
+		b.WriteString(strconv.Itoa(*o.RestartWith))
+	}
+	return b.String()
+}
+
+// prepareHasRowsQuery returns 1 when the table at fullPath has rows and 0 otherwise, reading one
+// row at most.
+func prepareHasRowsQuery(fullPath string) string {
+	return "SELECT COUNT(*) FROM (SELECT 1 FROM `" + helpers.EscapeYQLIdentifier(fullPath) + "` LIMIT 1)"
+}
@@ -0,0 +1,211 @@
+package table
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+var decimalTypeRegexp = regexp.MustCompile(`^(?i)decimal\(\s*(\d+)\s*,\s*(\d+)\s*\)$`)
+
+// parseDefault converts the textual default of a column of type typ into a typed YDB value.
+// YDB supports only literal column defaults, so expressions such as CurrentUtcTimestamp()
+// are rejected here.
+func parseDefault(typ, raw string) (types.Value, error) {
+	if isSerialColumn(typ) {
+		return nil, fmt.Errorf("serial columns cannot have a default: their values are taken from a sequence")
+	}
+	v, err := parseLiteral(typ, raw)
+	if errors.Is(err, errUnsupportedLiteralType) {
+		return nil, fmt.Errorf("defaults are not supported for columns of type %s", typ)
+	}
+	return v, err
+}
+
+// errUnsupportedLiteralType is returned by parseLiteral for types without a textual form.
+var errUnsupportedLiteralType = errors.New("unsupported type")
+
+// parseLiteral converts the textual form of a value of type typ into a typed YDB value. Dates
+// are written as 2006-01-02, other date and time types in RFC 3339 and intervals as Go
+// durations, e.g. 1h30m.
+func parseLiteral(typ, raw string) (types.Value, error) {
+	if m := decimalTypeRegexp.FindStringSubmatch(typ); m != nil {
+		precision, _ := strconv.ParseUint(m[1], 10, 32)
+		scale, _ := strconv.ParseUint(m[2], 10, 32)
+		return types.DecimalValueFromString(raw, uint32(precision), uint32(scale))
+	}
+
+	switch strings.ToLower(typ) {
+	case "bool":
+		v, err := strconv.ParseBool(raw)
+		return types.BoolValue(v), err
+	case "int8":
+		v, err := strconv.ParseInt(raw, 10, 8)
+		return types.Int8Value(int8(v)), err
+	case "int16":
+		v, err := strconv.ParseInt(raw, 10, 16)
+		return types.Int16Value(int16(v)), err
+	case "int32":
+		v, err := strconv.ParseInt(raw, 10, 32)
+		return types.Int32Value(int32(v)), err
+	case "int64":
+		v, err := strconv.ParseInt(raw, 10, 64)
+		return types.Int64Value(v), err
+	case "uint8":
+		v, err := strconv.ParseUint(raw, 10, 8)
+		return types.Uint8Value(uint8(v)), err
+	case "uint16":
+		v, err := strconv.ParseUint(raw, 10, 16)
+		return types.Uint16Value(uint16(v)), err
+	case "uint32":
+		v, err := strconv.ParseUint(raw, 10, 32)
+		return types.Uint32Value(uint32(v)), err
+	case "uint64":
+		v, err := strconv.ParseUint(raw, 10, 64)
+		return types.Uint64Value(v), err
+	case "float":
+		v, err := strconv.ParseFloat(raw, 32)
+		return types.FloatValue(float32(v)), err
+	case "double":
+		v, err := strconv.ParseFloat(raw, 64)
+		return types.DoubleValue(v), err
+	case "utf8", "text":
+		return types.TextValue(raw), nil
+	case "string", "bytes":
+		return types.BytesValue([]byte(raw)), nil
+	case "json":
+		return types.JSONValue(raw), nil
+	case "jsondocument":
+		return types.JSONDocumentValue(raw), nil
+	case "date":
+		t, err := time.Parse(time.DateOnly, raw)
+		return types.DateValueFromTime(t), err
+	case "datetime":
+		t, err := time.Parse(time.RFC3339, raw)
+		return types.DatetimeValueFromTime(t), err
+	case "timestamp":
+		t, err := time.Parse(time.RFC3339Nano, raw)
+		return types.TimestampValueFromTime(t), err
+	case "date32":
+		t, err := time.Parse(time.DateOnly, raw)
+		return types.Date32Value(int32(t.Unix() / secondsPerDay)), err
+	case "datetime64":
+		t, err := time.Parse(time.RFC3339, raw)
+		return types.Datetime64Value(t.Unix()), err
+	case "timestamp64":
+		t, err := time.Parse(time.RFC3339Nano, raw)
+		return types.Timestamp64Value(t.UnixMicro()), err
+	case "interval":
+		v, err := time.ParseDuration(raw)
+		return types.IntervalValueFromDuration(v), err
+	case "uuid":
+		v, err := uuid.Parse(raw)
+		return types.UuidValue(v), err
+	case "dynumber":
+		if _, err := strconv.ParseFloat(raw, 64); err != nil {
+			return nil, err
+		}
+		return types.DyNumberValue(raw), nil
+	}
+	return nil, errUnsupportedLiteralType
+}
+
+const secondsPerDay = 24 * 60 * 60
+
+// defaultToYQL renders the default of column c as a YQL literal.
+func defaultToYQL(c *Column) (string, error) {
+	v, err := parseDefault(c.Type, c.Default)
+	if err != nil {
+		return "", fmt.Errorf("invalid default %q for column %q: %w", c.Default, c.Name, err)
+	}
+	return v.Yql(), nil
+}
+
+// validateColumnDefaults checks that every column default is a literal of the column type.
+func validateColumnDefaults(columns []*Column) error {
+	for _, c := range columns {
+		if c.Default == "" {
+			continue
+		}
+		if _, err := defaultToYQL(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unwrapLiteral strips the Just(...) wrappers of optional literals.
+func unwrapLiteral(yql string) string {
+	for strings.HasPrefix(yql, "Just(") && strings.HasSuffix(yql, ")") {
+		yql = yql[len("Just(") : len(yql)-1]
+	}
+	return yql
+}
+
+// sameDefault reports whether the configured default of column c is the described literal.
+func sameDefault(c *Column, literal types.Value) bool {
+	if c.Default == "" || literal == nil {
+		return c.Default == "" && literal == nil
+	}
+	configured, err := defaultToYQL(c)
+	if err != nil {
+		return false
+	}
+	return configured == unwrapLiteral(literal.Yql())
+}
+
+// defaultLiteral returns the literal default of a described column, or nil for columns
+// without a default and serial columns, whose defaults come from sequences.
+func defaultLiteral(col options.Column) types.Value {
+	if col.DefaultValue == nil {
+		return nil
+	}
+	if literal := col.DefaultValue.Literal(); literal != nil {
+		return literal.Value
+	}
+	return nil
+}
+
+// flattenDefault converts a described literal default back to the textual form used in configuration.
+func flattenDefault(literal types.Value) string {
+	if literal == nil {
+		return ""
+	}
+	var s string
+	if err := types.CastTo(literal, &s); err == nil {
+		return s
+	}
+	var t time.Time
+	if err := types.CastTo(literal, &t); err == nil {
+		typ := literal.Type().Yql()
+		switch {
+		case strings.Contains(typ, "Timestamp"):
+			return t.UTC().Format(time.RFC3339Nano)
+		case strings.Contains(typ, "Datetime"):
+			return t.UTC().Format(time.RFC3339)
+		}
+		return t.UTC().Format(time.DateOnly)
+	}
+	var d time.Duration
+	if err := types.CastTo(literal, &d); err == nil {
+		return d.String()
+	}
+	// Other literals are constructors such as Uuid("...") or Decimal("1.5",22,9): return the
+	// quoted value.
+	yql := unwrapLiteral(literal.Yql())
+	if i := strings.IndexByte(yql, '('); i > 0 && strings.HasSuffix(yql, ")") {
+		if quoted, err := strconv.QuotedPrefix(yql[i+1:]); err == nil {
+			if s, err := strconv.Unquote(quoted); err == nil {
+				return s
+			}
+		}
+	}
+	return yql
+}
@@ -0,0 +1,87 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+func TestDefaultToYQL(t *testing.T) {
+	testData := []struct {
+		column        Column
+		expected      string
+		expectedError bool
+	}{
+		{column: Column{Type: "Utf8", Default: "new"}, expected: `"new"u`},
+		{column: Column{Type: "String", Default: "a\"b"}, expected: `"a\"b"`},
+		{column: Column{Type: "Bool", Default: "true"}, expected: "true"},
+		{column: Column{Type: "int64", Default: "-5"}, expected: "-5l"},
+		{column: Column{Type: "Uint8", Default: "7"}, expected: "7ut"},
+		{column: Column{Type: "Timestamp", Default: "2024-01-02T03:04:05Z"}, expected: `Timestamp("2024-01-02T03:04:05.000000Z")`},
+		{column: Column{Type: "Date", Default: "2024-01-02"}, expected: `Date("2024-01-02")`},
+		{column: Column{Type: "Uint8", Default: "300"}, expectedError: true},
+		{column: Column{Type: "Timestamp", Default: "CurrentUtcTimestamp()"}, expectedError: true},
+		{column: Column{Type: "Serial", Default: "1"}, expectedError: true},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.column.Type+"/"+v.column.Default, func(t *testing.T) {
+			got, err := defaultToYQL(&v.column)
+			if v.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, v.expected, got)
+		})
+	}
+}
+
+func TestColumnToYQLDefault(t *testing.T) {
+	got, err := (&Column{Name: "a", Type: "Uint8", Default: "7", NotNull: true}).ToYQL()
+	require.NoError(t, err)
+	assert.Equal(t, "`a` Uint8 NOT NULL DEFAULT 7ut", got)
+
+	_, err = (&Column{Name: "a", Type: "Uint8", Default: "300"}).ToYQL()
+	assert.ErrorContains(t, err, `invalid default "300" for column "a"`)
+
+	_, err = PrepareCreateRequest(&Resource{
+		FullPath:   "t",
+		Columns:    []*Column{{Name: "a", Type: "Uint8", Default: "300"}},
+		PrimaryKey: &PrimaryKey{Columns: []string{"a"}},
+	})
+	assert.Error(t, err, "the column is not created without its default")
+}
+
+func TestFlattenDefault(t *testing.T) {
+	testData := []struct {
+		column Column
+		flat   string
+	}{
+		{column: Column{Type: "Utf8", Default: "new"}, flat: "new"},
+		{column: Column{Type: "Int32", Default: "+42"}, flat: "42"},
+		{column: Column{Type: "Bool", Default: "1"}, flat: "true"},
+		{column: Column{Type: "Timestamp", Default: "2024-01-02T03:04:05Z"}, flat: "2024-01-02T03:04:05Z"},
+		{column: Column{Type: "Datetime", Default: "2024-01-02T03:04:05Z"}, flat: "2024-01-02T03:04:05Z"},
+		{column: Column{Type: "Date", Default: "2024-01-02"}, flat: "2024-01-02"},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.column.Type+"/"+v.column.Default, func(t *testing.T) {
+			literal, err := parseDefault(v.column.Type, v.column.Default)
+			require.NoError(t, err)
+			assert.Equal(t, v.flat, flattenDefault(literal))
+			assert.True(t, sameDefault(&v.column, literal))
+			assert.True(t, sameDefault(&v.column, types.OptionalValue(literal)))
+		})
+	}
+
+	assert.Equal(t, "", flattenDefault(nil))
+	assert.True(t, sameDefault(&Column{Type: "Utf8"}, nil))
+	assert.False(t, sameDefault(&Column{Type: "Utf8", Default: "a"}, nil))
+	assert.False(t, sameDefault(&Column{Type: "Utf8", Default: "a"}, types.TextValue("b")))
+}
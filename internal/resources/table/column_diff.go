@@ -1,10 +1,13 @@
 package table
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-// ValidateResourceDiffColumns rejects unsupported column schema changes at plan time.
+// ValidateResourceDiffColumns rejects unsupported column schema changes at plan time and plans
+// the columns to drop when allow_column_drop is set.
 func ValidateResourceDiffColumns(d *schema.ResourceDiff) error {
 	if !d.HasChange("column") && len(d.GetChangedKeysPrefix("column")) == 0 {
 		return nil
@@ -13,6 +16,23 @@ func ValidateResourceDiffColumns(d *schema.ResourceDiff) error {
 	if o == nil || n == nil {
 		return nil
 	}
-	_, err := checkColumnDiff(expandColumns(n), expandColumns(o))
-	return err
+	if err := validateColumnDefaults(expandColumns(n)); err != nil {
+		return err
+	}
+	columnsToAdd, columnsToDrop, err := checkColumnDiff(expandColumns(n), expandColumns(o), d.Get("allow_column_drop").(bool))
+	if err != nil {
+		return err
+	}
+	if d.Id() == "" {
+		return nil
+	}
+	if err := validateColumnDrops(d, columnsToDrop); err != nil {
+		return err
+	}
+	for _, c := range columnsToAdd {
+		if isSerialColumn(c.Type) {
+			return fmt.Errorf("cannot add serial column %q: YDB creates serial columns only together with the table", c.Name)
+		}
+	}
+	return nil
 }
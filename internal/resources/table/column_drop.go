@@ -0,0 +1,138 @@
+package table
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ydb "github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
+)
+
+// validateColumnDrops checks the columns removed from an existing table at plan time. The dropped
+// columns are planned as the new value of dropped_columns, so the plan lists the columns an apply
+// will destroy.
+func validateColumnDrops(d *schema.ResourceDiff, toDrop []string) error {
+	if d.Id() == "" || len(toDrop) == 0 {
+		return nil
+	}
+	for _, k := range migrationKeys {
+		if !d.HasChange(k) {
+			continue
+		}
+		if d.Get("migration_strategy").(string) == MigrationStrategyCopy {
+			return fmt.Errorf("columns cannot be dropped during a copy migration: drop [%s] in a separate apply", strings.Join(toDrop, ","))
+		}
+		// The table is recreated without the columns.
+		return nil
+	}
+
+	o, n := d.GetChange("primary_key")
+	primaryKey := append(expandStrings(o), expandStrings(n)...)
+	if err := checkColumnDrops(toDrop, primaryKey, ttlColumnName(d.Get("ttl"))); err != nil {
+		return err
+	}
+	return d.SetNew("dropped_columns", toDrop)
+}
+
+// checkColumnDrops refuses to drop the primary key columns, including the ones of the previous
+// primary key, and the TTL column.
+func checkColumnDrops(toDrop, primaryKey []string, ttlColumn string) error {
+	var problems []string
+	for _, name := range toDrop {
+		switch {
+		case slices.Contains(primaryKey, name):
+			problems = append(problems, fmt.Sprintf("column %q is a primary key column", name))
+		case name == ttlColumn:
+			problems = append(problems, fmt.Sprintf("column %q is the TTL column", name))
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("cannot drop columns: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// checkIndexedColumnDrops refuses to drop the key and covered columns of the secondary indexes
+// of the table. The indexes must be changed or dropped first.
+func checkIndexedColumnDrops(toDrop []string, indexes []options.IndexDescription) error {
+	var problems []string
+	for _, name := range toDrop {
+		for _, idx := range indexes {
+			switch {
+			case slices.Contains(idx.IndexColumns, name):
+				problems = append(problems, fmt.Sprintf("column %q is a key column of index %q", name, idx.Name))
+			case slices.Contains(idx.DataColumns, name):
+				problems = append(problems, fmt.Sprintf("column %q is covered by index %q", name, idx.Name))
+			}
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("cannot drop columns: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// ValidateIndexedColumnDrops checks at plan time that the columns dropped from an existing table
+// are not used by its secondary indexes. The check is skipped while the table cannot be described.
+func ValidateIndexedColumnDrops(ctx context.Context, d *schema.ResourceDiff, authCreds auth.YdbCredentials) error {
+	if d.Id() == "" || !d.Get("allow_column_drop").(bool) || !d.HasChange("column") {
+		return nil
+	}
+	o, n := d.GetChange("column")
+	_, toDrop, err := checkColumnDiff(expandColumns(n), expandColumns(o), true)
+	if err != nil || len(toDrop) == 0 {
+		return nil
+	}
+
+	entity, err := helpers.ParseYDBEntityID(d.Id())
+	if err != nil {
+		return fmt.Errorf("failed to parse table entity: %w", err)
+	}
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: entity.PrepareFullYDBEndpoint(),
+		AuthCreds:        authCreds,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to initialize table client: %w", err)
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	desc, err := db.DescribeTable(ctx, entity.GetFullEntityPath())
+	if err != nil {
+		if ydb.IsOperationErrorSchemeError(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to describe table %q: %w", entity.GetEntityPath(), err)
+	}
+	return checkIndexedColumnDrops(toDrop, desc.Indexes)
+}
+
+func ttlColumnName(raw interface{}) string {
+	set, ok := raw.(*schema.Set)
+	if !ok || set.Len() == 0 {
+		return ""
+	}
+	m, _ := set.List()[0].(map[string]interface{})
+	name, _ := m["column_name"].(string)
+	return name
+}
+
+func expandStrings(raw interface{}) []string {
+	list, _ := raw.([]interface{})
+	res := make([]string, 0, len(list))
+	for _, v := range list {
+		if s, ok := v.(string); ok {
+			res = append(res, s)
+		}
+	}
+	return res
+}
@@ -0,0 +1,31 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+)
+
+func TestCheckColumnDrops(t *testing.T) {
+	require.NoError(t, checkColumnDrops([]string{"payload"}, []string{"id"}, "created_at"))
+
+	err := checkColumnDrops([]string{"id", "payload", "created_at"}, []string{"id"}, "created_at")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `column "id" is a primary key column`)
+	assert.Contains(t, err.Error(), `column "created_at" is the TTL column`)
+	assert.NotContains(t, err.Error(), "payload")
+}
+
+func TestCheckIndexedColumnDrops(t *testing.T) {
+	indexes := []options.IndexDescription{
+		{Name: "by_user", IndexColumns: []string{"user_id"}, DataColumns: []string{"email"}},
+	}
+	require.NoError(t, checkIndexedColumnDrops([]string{"payload"}, indexes))
+
+	err := checkIndexedColumnDrops([]string{"user_id", "email"}, indexes)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `column "user_id" is a key column of index "by_user"`)
+	assert.Contains(t, err.Error(), `column "email" is covered by index "by_user"`)
+}
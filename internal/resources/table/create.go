@@ -5,7 +5,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 
 	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
 )
@@ -40,10 +39,10 @@ func (h *handler) Create(ctx context.Context, d *schema.ResourceData, meta inter
 		_ = db.Close(ctx)
 	}()
 
-	q := PrepareCreateRequest(tableResource)
-	err = db.Table().Do(ctx, func(ctx context.Context, s table.Session) (err error) {
-		return s.ExecuteSchemeQuery(ctx, q)
-	})
+	q, err := PrepareCreateRequest(tableResource)
+	if err == nil {
+		err = db.ExecuteSchemeQuery(ctx, q, tbl.NonIdempotent)
+	}
 	if err != nil {
 		return diag.Diagnostics{
 			diag.Diagnostic{
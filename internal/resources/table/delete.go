@@ -5,7 +5,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 
 	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
 )
@@ -35,12 +34,13 @@ func (h *handler) Delete(ctx context.Context, d *schema.ResourceData, cfg interf
 		_ = db.Close(ctx)
 	}()
 
-	err = db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
-		query := PrepareDropTableRequest(tableResource.Path)
-		return s.ExecuteSchemeQuery(ctx, query)
-	})
+	err = db.ExecuteSchemeQuery(ctx, PrepareDropTableRequest(tableResource.Path), tbl.NonIdempotent)
 	if err != nil {
 		return diag.Errorf("failed to drop table %q: %s", tableResource.Path, err)
 	}
+	backups := expandMigrationBackups(d.Get("migration_backups"))
+	if _, err = dropBackups(ctx, db, backups, backups); err != nil {
+		return diag.FromErr(err)
+	}
 	return nil
 }
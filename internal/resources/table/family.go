@@ -0,0 +1,216 @@
+package table
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+)
+
+// defaultFamily is the family columns belong to unless they are placed in another one.
+const defaultFamily = "default"
+
+// familyChange holds the settings of an existing column family that have to be altered.
+// Empty fields are left as they are.
+type familyChange struct {
+	Name             string
+	Data             string
+	Compression      string
+	CompressionLevel int
+}
+
+func (c *familyChange) empty() bool {
+	return c.Data == "" && c.Compression == "" && c.CompressionLevel == 0
+}
+
+// sameFamily reports whether a and b name the same family of a column, treating an empty name
+// as the default family.
+func sameFamily(a, b string) bool {
+	if a == "" {
+		a = defaultFamily
+	}
+	if b == "" {
+		b = defaultFamily
+	}
+	return a == b
+}
+
+func expandFamilies(familiesRaw interface{}) []*Family {
+	raw, _ := familiesRaw.([]interface{})
+	families := make([]*Family, 0, len(raw))
+	for _, rw := range raw {
+		r, ok := rw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		f := &Family{
+			Name:        r["name"].(string),
+			Data:        r["data"].(string),
+			Compression: r["compression"].(string),
+		}
+		if level, ok := r["compression_level"].(int); ok {
+			f.CompressionLevel = level
+		}
+		families = append(families, f)
+	}
+	return families
+}
+
+// checkFamilyDiff returns the families to add and the changes of the existing ones. YDB cannot
+// drop column families, so removing one from the configuration is an error.
+func checkFamilyDiff(newFamilies, oldFamilies []*Family) ([]*Family, []*familyChange, error) {
+	existing := make(map[string]*Family, len(oldFamilies))
+	for _, f := range oldFamilies {
+		existing[f.Name] = f
+	}
+	configured := make(map[string]bool, len(newFamilies))
+	var toAdd []*Family
+	var toAlter []*familyChange
+	for _, f := range newFamilies {
+		configured[f.Name] = true
+		old, ok := existing[f.Name]
+		if !ok {
+			toAdd = append(toAdd, f)
+			continue
+		}
+		change := &familyChange{Name: f.Name}
+		if !strings.EqualFold(old.Data, f.Data) {
+			change.Data = f.Data
+		}
+		if !strings.EqualFold(old.Compression, f.Compression) {
+			change.Compression = f.Compression
+		}
+		if old.CompressionLevel != f.CompressionLevel {
+			change.CompressionLevel = f.CompressionLevel
+		}
+		if !change.empty() {
+			toAlter = append(toAlter, change)
+		}
+	}
+
+	var removed []string
+	for _, f := range oldFamilies {
+		if !configured[f.Name] {
+			removed = append(removed, f.Name)
+		}
+	}
+	if len(removed) > 0 {
+		return nil, nil, fmt.Errorf("column families cannot be dropped in YDB. Families for deletion: [%s]", strings.Join(removed, ","))
+	}
+	return toAdd, toAlter, nil
+}
+
+// checkColumnFamilyChanges returns the existing columns moved to another family. A column without
+// a configured family stays where it is: set the family to "default" to move it back.
+func checkColumnFamilyChanges(newColumns, oldColumns []*Column) []*Column {
+	existing := make(map[string]*Column, len(oldColumns))
+	for _, c := range oldColumns {
+		existing[c.Name] = c
+	}
+	var moved []*Column
+	for _, c := range newColumns {
+		old, ok := existing[c.Name]
+		if !ok || c.Family == "" || sameFamily(c.Family, old.Family) {
+			continue
+		}
+		moved = append(moved, c)
+	}
+	sort.Slice(moved, func(i, j int) bool {
+		return moved[i].Name < moved[j].Name
+	})
+	return moved
+}
+
+// ValidateResourceDiffFamilies checks the column families at plan time: families cannot be
+// dropped, columns must reference declared families, and zstd compression and compression levels
+// are only available for column tables.
+func ValidateResourceDiffFamilies(d *schema.ResourceDiff) error {
+	families := expandFamilies(d.Get("family"))
+	columnStore := strings.EqualFold(d.Get("store").(string), "column")
+	declared := map[string]bool{defaultFamily: true}
+	for _, f := range families {
+		declared[f.Name] = true
+		if !columnStore && strings.EqualFold(f.Compression, "zstd") {
+			return fmt.Errorf("family %q: zstd compression is supported for column tables only", f.Name)
+		}
+		if !columnStore && f.CompressionLevel != 0 {
+			return fmt.Errorf("family %q: compression_level is supported for column tables only", f.Name)
+		}
+	}
+	if c, ok := d.Get("column").(*schema.Set); ok {
+		for _, col := range expandColumns(c) {
+			if col.Family != "" && !declared[col.Family] {
+				return fmt.Errorf("column %q references undeclared family %q", col.Name, col.Family)
+			}
+		}
+	}
+	if d.Id() == "" || !d.HasChange("family") {
+		return nil
+	}
+	o, n := d.GetChange("family")
+	_, _, err := checkFamilyDiff(expandFamilies(n), expandFamilies(o))
+	return err
+}
+
+func flattenCompression(c options.ColumnFamilyCompression) string {
+	switch c {
+	case options.ColumnFamilyCompressionNone:
+		return "off"
+	case options.ColumnFamilyCompressionLZ4:
+		return "lz4"
+	}
+	return ""
+}
+
+// flattenColumnFamilies converts the described column families in the order of the configured
+// ones. The implicit default family is skipped unless it is configured. Settings the description
+// does not report, such as zstd compression and compression levels, are kept as configured.
+func flattenColumnFamilies(configured []*Family, described []options.ColumnFamily) []interface{} {
+	order := make(map[string]int, len(configured))
+	byName := make(map[string]*Family, len(configured))
+	for i, f := range configured {
+		order[f.Name] = i
+		byName[f.Name] = f
+	}
+
+	families := make([]options.ColumnFamily, 0, len(described))
+	for _, f := range described {
+		if _, ok := byName[f.Name]; ok || f.Name != defaultFamily {
+			families = append(families, f)
+		}
+	}
+	sort.SliceStable(families, func(i, j int) bool {
+		oi, iok := order[families[i].Name]
+		oj, jok := order[families[j].Name]
+		switch {
+		case iok && jok:
+			return oi < oj
+		case iok != jok:
+			return iok
+		}
+		return families[i].Name < families[j].Name
+	})
+
+	res := make([]interface{}, 0, len(families))
+	for _, f := range families {
+		data, compression, level := f.Data.Media, flattenCompression(f.Compression), 0
+		if c, ok := byName[f.Name]; ok {
+			if data == "" || strings.EqualFold(data, c.Data) {
+				data = c.Data
+			}
+			if compression == "" || strings.EqualFold(compression, c.Compression) {
+				compression = c.Compression
+			}
+			level = c.CompressionLevel
+		}
+		res = append(res, map[string]interface{}{
+			"name":              f.Name,
+			"data":              data,
+			"compression":       compression,
+			"compression_level": level,
+		})
+	}
+	return res
+}
@@ -0,0 +1,69 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+)
+
+func TestCheckFamilyDiff(t *testing.T) {
+	oldFamilies := []*Family{
+		{Name: "default", Data: "ssd", Compression: "off"},
+		{Name: "cold", Data: "rot", Compression: "lz4"},
+	}
+
+	toAdd, toAlter, err := checkFamilyDiff([]*Family{
+		{Name: "default", Data: "SSD", Compression: "lz4"},
+		{Name: "cold", Data: "rot", Compression: "lz4"},
+		{Name: "archive", Data: "rot", Compression: "lz4"},
+	}, oldFamilies)
+	require.NoError(t, err)
+	assert.Equal(t, []*Family{{Name: "archive", Data: "rot", Compression: "lz4"}}, toAdd)
+	assert.Equal(t, []*familyChange{{Name: "default", Compression: "lz4"}}, toAlter)
+
+	_, _, err = checkFamilyDiff([]*Family{{Name: "default", Data: "ssd", Compression: "off"}}, oldFamilies)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "[cold]")
+}
+
+func TestCheckColumnFamilyChanges(t *testing.T) {
+	oldColumns := []*Column{
+		{Name: "a", Type: "Uint64"},
+		{Name: "b", Type: "Utf8", Family: "cold"},
+		{Name: "c", Type: "Utf8"},
+		{Name: "d", Type: "Utf8", Family: "cold"},
+	}
+	newColumns := []*Column{
+		{Name: "a", Type: "Uint64", Family: "default"},
+		{Name: "b", Type: "Utf8", Family: "default"},
+		{Name: "c", Type: "Utf8", Family: "cold"},
+		{Name: "d", Type: "Utf8"},
+		{Name: "e", Type: "Utf8", Family: "cold"},
+	}
+	assert.Equal(t, []*Column{
+		{Name: "b", Type: "Utf8", Family: "default"},
+		{Name: "c", Type: "Utf8", Family: "cold"},
+	}, checkColumnFamilyChanges(newColumns, oldColumns))
+}
+
+func TestFlattenColumnFamilies(t *testing.T) {
+	described := []options.ColumnFamily{
+		{Name: "default", Data: options.StoragePool{Media: "ssd"}, Compression: options.ColumnFamilyCompressionNone},
+		{Name: "manual", Data: options.StoragePool{Media: "ssd"}, Compression: options.ColumnFamilyCompressionLZ4},
+		{Name: "cold", Data: options.StoragePool{Media: "rot"}, Compression: options.ColumnFamilyCompressionUnknown},
+	}
+	configured := []*Family{
+		{Name: "cold", Data: "ROT", Compression: "zstd", CompressionLevel: 5},
+	}
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"name": "cold", "data": "ROT", "compression": "zstd", "compression_level": 5},
+		map[string]interface{}{"name": "manual", "data": "ssd", "compression": "lz4", "compression_level": 0},
+	}, flattenColumnFamilies(configured, described))
+
+	configured = append(configured, &Family{Name: "default", Data: "ssd", Compression: "lz4"})
+	assert.Equal(t, map[string]interface{}{
+		"name": "default", "data": "ssd", "compression": "off", "compression_level": 0,
+	}, flattenColumnFamilies(configured, described)[1])
+}
@@ -5,7 +5,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 
 	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
 )
@@ -34,9 +33,7 @@ func (h *handler) dropIndex(ctx context.Context, params dropIndexParams) diag.Di
 		_ = db.Close(ctx)
 	}()
 
-	err = db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
-		return s.ExecuteSchemeQuery(ctx, prepareDropRequest(params.tablePath, params.name))
-	})
+	err = db.ExecuteSchemeQuery(ctx, prepareDropRequest(params.tablePath, params.name), tbl.NonIdempotent)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -0,0 +1,45 @@
+package index
+
+import (
+	"context"
+	"fmt"
+
+	ydb "github.com/ydb-platform/ydb-go-sdk/v3"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func indexID(r *resource) string {
+	return r.getConnectionString() + "?path=" + r.getTablePath() + "/" + r.Name
+}
+
+// moveIndex points the index resource to the table it is configured for now. An index is moved
+// together with its table when the table is renamed, so an index already present on the new
+// table is adopted. Otherwise the index is created on the new table and dropped from the old one.
+// It returns the new resource ID and whether the index was created from its current definition.
+func moveIndex(ctx context.Context, db *tbl.Driver, r *resource) (string, bool, error) {
+	id := indexID(r)
+	entity, err := helpers.ParseYDBEntityID(id)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to parse index entity: %w", err)
+	}
+
+	description, err := db.DescribeTable(ctx, parseTablePathFromIndexEntity(entity.GetFullEntityPath()))
+	if err != nil {
+		return "", false, fmt.Errorf("failed to describe table %q: %w", r.getTablePath(), err)
+	}
+	if _, ok := findIndex(description, r.Name); ok {
+		return id, false, nil
+	}
+
+	if err = db.ExecuteSchemeQuery(ctx, prepareCreateIndexRequest(r), tbl.NonIdempotent); err != nil {
+		return "", false, fmt.Errorf("failed to create index %q on table %q: %w", r.Name, r.getTablePath(), err)
+	}
+	oldTablePath := parseTablePathFromIndexEntity(r.Entity.GetEntityPath())
+	err = db.ExecuteSchemeQuery(ctx, prepareDropRequest(oldTablePath, r.Name), tbl.NonIdempotent)
+	if err != nil && !ydb.IsOperationErrorSchemeError(err) {
+		return "", false, fmt.Errorf("failed to drop index %q from table %q: %w", r.Name, oldTablePath, err)
+	}
+	return id, true, nil
+}
@@ -0,0 +1,119 @@
+package index
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+const (
+	// StrategyRecreate drops the index and creates it again when its definition changes.
+	StrategyRecreate = "recreate"
+	// StrategyBuildAndSwap builds the new index under a temporary name and swaps it in once built.
+	StrategyBuildAndSwap = "build_and_swap"
+
+	temporaryIndexSuffix = "_tf_replace"
+)
+
+// indexBuildPollInterval is how often the build of the temporary index is checked.
+var indexBuildPollInterval = 5 * time.Second
+
+func temporaryIndexName(name string) string {
+	return name + temporaryIndexSuffix
+}
+
+// renameIndex renames an index, atomically replacing the destination index if it exists.
+// The SDK has no option for this, and YQL `RENAME INDEX` cannot replace an existing index.
+type renameIndex struct {
+	from, to string
+}
+
+func (r renameIndex) ApplyAlterTableOption(d *options.AlterTableDesc) {
+	d.RenameIndexes = append(d.RenameIndexes, &Ydb_Table.RenameIndexItem{
+		SourceName:         r.from,
+		DestinationName:    r.to,
+		ReplaceDestination: true,
+	})
+}
+
+func findIndex(description options.Description, name string) (options.IndexDescription, bool) {
+	for _, idx := range description.Indexes {
+		if idx.Name == name {
+			return idx, true
+		}
+	}
+	return options.IndexDescription{}, false
+}
+
+// isIndexReady reports whether the index is usable. Servers that do not report index status
+// return STATUS_UNSPECIFIED, which is treated as ready.
+func isIndexReady(idx options.IndexDescription) bool {
+	return idx.Status != Ydb_Table.TableIndexDescription_STATUS_BUILDING
+}
+
+// buildAndSwap replaces the index described by r without a window in which the table has no
+// index named r.Name: the new definition is built as a temporary index, and only when its build
+// is finished is it renamed over the old one.
+func buildAndSwap(ctx context.Context, db *tbl.Driver, r *resource) error {
+	tablePath := r.getTablePath()
+	fullTablePath := parseTablePathFromIndexEntity(r.Entity.GetFullEntityPath())
+
+	tmp := *r
+	tmp.Name = temporaryIndexName(r.Name)
+
+	description, err := db.DescribeTable(ctx, fullTablePath)
+	if err != nil {
+		return fmt.Errorf("failed to describe table %q: %w", fullTablePath, err)
+	}
+	if _, ok := findIndex(description, tmp.Name); ok {
+		// Left over from an interrupted replacement, its definition may be outdated.
+		log.Printf("[WARN] dropping leftover index %q of table %q", tmp.Name, fullTablePath)
+		if err = db.ExecuteSchemeQuery(ctx, prepareDropRequest(tablePath, tmp.Name), tbl.NonIdempotent); err != nil {
+			return fmt.Errorf("failed to drop leftover index %q: %w", tmp.Name, err)
+		}
+	}
+
+	if err = db.ExecuteSchemeQuery(ctx, prepareCreateIndexRequest(&tmp), tbl.NonIdempotent); err != nil {
+		return fmt.Errorf("failed to build index %q: %w", tmp.Name, err)
+	}
+	if err = waitIndexReady(ctx, db, fullTablePath, tmp.Name); err != nil {
+		return err
+	}
+
+	err = db.AlterTable(ctx, fullTablePath, tbl.NonIdempotent, renameIndex{from: tmp.Name, to: r.Name})
+	if err != nil {
+		return fmt.Errorf("failed to rename index %q to %q: %w", tmp.Name, r.Name, err)
+	}
+	return nil
+}
+
+func waitIndexReady(ctx context.Context, db *tbl.Driver, fullTablePath, name string) error {
+	for {
+		description, err := db.DescribeTable(ctx, fullTablePath)
+		if err != nil {
+			return fmt.Errorf("failed to describe table %q: %w", fullTablePath, err)
+		}
+		idx, ok := findIndex(description, name)
+		if !ok {
+			return fmt.Errorf("index %q of table %q disappeared before its build finished", name, fullTablePath)
+		}
+		if isIndexReady(idx) {
+			return nil
+		}
+
+		log.Printf("[DEBUG] index %q of table %q is still building", name, fullTablePath)
+		timer := time.NewTimer(indexBuildPollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("waiting for index %q to be built: %w", name, ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package index
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+)
+
+func TestRenameIndexOption(t *testing.T) {
+	var desc options.AlterTableDesc
+	renameIndex{from: "idx_tf_replace", to: "idx"}.ApplyAlterTableOption(&desc)
+
+	assert.Len(t, desc.RenameIndexes, 1)
+	assert.Equal(t, "idx_tf_replace", desc.RenameIndexes[0].GetSourceName())
+	assert.Equal(t, "idx", desc.RenameIndexes[0].GetDestinationName())
+	assert.True(t, desc.RenameIndexes[0].GetReplaceDestination())
+}
+
+func TestIndexReadiness(t *testing.T) {
+	description := options.Description{
+		Indexes: []options.IndexDescription{
+			{Name: "idx", Status: Ydb_Table.TableIndexDescription_STATUS_READY},
+			{Name: "idx_tf_replace", Status: Ydb_Table.TableIndexDescription_STATUS_BUILDING},
+			{Name: "legacy"},
+		},
+	}
+
+	idx, ok := findIndex(description, "idx")
+	assert.True(t, ok)
+	assert.True(t, isIndexReady(idx))
+
+	idx, ok = findIndex(description, temporaryIndexName("idx"))
+	assert.True(t, ok)
+	assert.False(t, isIndexReady(idx))
+
+	idx, ok = findIndex(description, "legacy")
+	assert.True(t, ok)
+	assert.True(t, isIndexReady(idx), "unspecified status is reported by servers without index status")
+
+	_, ok = findIndex(description, "missing")
+	assert.False(t, ok)
+}
+
+func TestPrepareTemporaryIndexRequest(t *testing.T) {
+	r := &resource{
+		TablePath: "table",
+		Name:      temporaryIndexName("by_user"),
+		Type:      "global_async",
+		Columns:   []string{"user_id", "created_at"},
+		Cover:     []string{"payload"},
+	}
+	assert.Equal(t,
+		"ALTER TABLE `table` ADD INDEX `by_user_tf_replace` GLOBAL ASYNC ON (`user_id`, `created_at`) COVER (`payload`)",
+		prepareCreateIndexRequest(r),
+	)
+}
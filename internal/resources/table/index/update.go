@@ -5,18 +5,70 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
 )
 
+// Update moves the index to another table when table_path or table_id change, e.g. after the
+// table is renamed. Changes of the index definition reach it only with replacement_strategy =
+// "build_and_swap": otherwise they force a new resource (see ResourceCustomizeDiff).
 func (h *handler) Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// NOTE(shmel1k@): currently all parameters are 'force new', so only read can be done here.
-	err := h.dropIndex(ctx, dropIndexParams{
-		name:             d.Get("name").(string),
-		databaseEndpoint: d.Get("connection_string").(string),
-		tablePath:        d.Get("table_path").(string),
+	moved := d.HasChanges("table_path", "table_id")
+	if !moved && !d.HasChanges("type", "columns", "cover") {
+		return h.Read(ctx, d, meta)
+	}
+
+	indexResource, err := indexResourceSchemaToIndexResource(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: indexResource.getConnectionString(),
+		AuthCreds:        h.authCreds,
 	})
 	if err != nil {
-		return err
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "failed to initialize table client",
+				Detail:   err.Error(),
+			},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	if moved {
+		id, created, err := moveIndex(ctx, db, indexResource)
+		if err != nil {
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "failed to move index " + indexResource.Name,
+					Detail:   err.Error(),
+				},
+			}
+		}
+		d.SetId(id)
+		if created || !d.HasChanges("type", "columns", "cover") {
+			return h.Read(ctx, d, meta)
+		}
+		if indexResource, err = indexResourceSchemaToIndexResource(d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err = buildAndSwap(ctx, db, indexResource); err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "failed to replace index " + indexResource.Name,
+				Detail:   err.Error(),
+			},
+		}
 	}
 
-	return h.Create(ctx, d, meta)
+	return h.Read(ctx, d, meta)
 }
@@ -0,0 +1,409 @@
+package table
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ydb "github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/query"
+	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
+)
+
+const (
+	// MigrationStrategyRecreate drops and recreates the table when a ForceNew argument changes.
+	MigrationStrategyRecreate = "recreate"
+	// MigrationStrategyCopy migrates the rows to a new table and keeps the old one as a backup.
+	MigrationStrategyCopy = "copy"
+
+	migrationTempSuffix   = "_tf_migration_"
+	migrationBackupSuffix = "_tf_backup_"
+	migrationTimeLayout   = "20060102150405"
+	migrationBatchSize    = 1000
+)
+
+// migrationKeys are the arguments that cannot be altered in place. They force a new table unless
+// the copy migration strategy is used.
+var migrationKeys = []string{
+	"primary_key",
+	"store",
+	"partitioning_settings.0.partition_by",
+	"partitioning_settings.0.partition_at_keys",
+}
+
+// copyOnlyMigrationKeys are the migrationKeys that never recreate the table: the split points
+// may also change when they drift on refresh, so a change is rejected unless the copy migration
+// strategy is used.
+var copyOnlyMigrationKeys = map[string]bool{
+	"partitioning_settings.0.partition_at_keys": true,
+}
+
+// MigrationBackup is a table kept under a backup name after a copy migration.
+type MigrationBackup struct {
+	Path      string
+	ExpiresAt time.Time
+}
+
+func (b *MigrationBackup) expired(now time.Time) bool {
+	return !b.ExpiresAt.IsZero() && !now.Before(b.ExpiresAt)
+}
+
+// ValidateResourceDiffMigration forces a new table when an argument from migrationKeys changes,
+// unless the copy migration strategy is used. Changes of copyOnlyMigrationKeys are rejected
+// without the copy strategy instead. It also plans the migration backups update when a
+// migration is about to run or a backup retention has expired.
+func ValidateResourceDiffMigration(d *schema.ResourceDiff) error {
+	if d.Id() == "" {
+		return nil
+	}
+	migrate := false
+	for _, k := range migrationKeys {
+		if !d.HasChange(k) {
+			continue
+		}
+		if d.Get("migration_strategy").(string) != MigrationStrategyCopy {
+			if copyOnlyMigrationKeys[k] {
+				return fmt.Errorf("%s can only be changed with migration_strategy = %q: "+
+					"YDB sets it when the table is created, and recreating the table would drop its rows", k, MigrationStrategyCopy)
+			}
+			if err := d.ForceNew(k); err != nil {
+				return err
+			}
+			continue
+		}
+		migrate = true
+	}
+	if migrate || len(expiredBackups(expandMigrationBackups(d.Get("migration_backups")), time.Now())) > 0 {
+		return d.SetNewComputed("migration_backups")
+	}
+	return nil
+}
+
+// migrationRequired reports whether the update migrates the table with the copy strategy.
+func migrationRequired(d interface {
+	Get(string) interface{}
+	HasChange(string) bool
+}) bool {
+	if d.Get("migration_strategy").(string) != MigrationStrategyCopy {
+		return false
+	}
+	for _, k := range migrationKeys {
+		if d.HasChange(k) {
+			return true
+		}
+	}
+	return false
+}
+
+func expandMigrationRetention(d *schema.ResourceData) (time.Duration, error) {
+	v, ok := d.GetOk("migration_backup_retention")
+	if !ok {
+		return 0, nil
+	}
+	return time.ParseDuration(v.(string))
+}
+
+func expandMigrationBackups(raw interface{}) []*MigrationBackup {
+	list, _ := raw.([]interface{})
+	backups := make([]*MigrationBackup, 0, len(list))
+	for _, v := range list {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		b := &MigrationBackup{Path: m["path"].(string)}
+		if s, _ := m["expires_at"].(string); s != "" {
+			b.ExpiresAt, _ = time.Parse(time.RFC3339, s)
+		}
+		backups = append(backups, b)
+	}
+	return backups
+}
+
+func flattenMigrationBackups(backups []*MigrationBackup) []interface{} {
+	res := make([]interface{}, 0, len(backups))
+	for _, b := range backups {
+		expiresAt := ""
+		if !b.ExpiresAt.IsZero() {
+			expiresAt = b.ExpiresAt.UTC().Format(time.RFC3339)
+		}
+		res = append(res, map[string]interface{}{
+			"path":       b.Path,
+			"expires_at": expiresAt,
+		})
+	}
+	return res
+}
+
+func expiredBackups(backups []*MigrationBackup, now time.Time) []*MigrationBackup {
+	var res []*MigrationBackup
+	for _, b := range backups {
+		if b.expired(now) {
+			res = append(res, b)
+		}
+	}
+	return res
+}
+
+// migrationPaths returns the temporary path the new table is created at and the backup path the
+// old table is renamed to.
+func migrationPaths(path string, now time.Time) (tmpPath, backupPath string) {
+	ts := now.UTC().Format(migrationTimeLayout)
+	return path + migrationTempSuffix + ts, path + migrationBackupSuffix + ts
+}
+
+func absolutePath(db *tbl.Driver, path string) string {
+	return strings.TrimSuffix(db.Name(), "/") + "/" + path
+}
+
+// checkMigrationDependents refuses to migrate a table that has serial columns, secondary
+// indexes, changefeeds or explicit permissions. The sequences of serial columns are created anew
+// with the new table and would hand out the copied values again. The other objects belong to the
+// table object, so they would stay on the backup table after the swap while ydb_table_index,
+// ydb_table_changefeed and ydb_permissions still point at the table path.
+func checkMigrationDependents(path string, serial []string, desc options.Description, entry scheme.Entry) error {
+	if len(serial) > 0 {
+		return fmt.Errorf("table %q cannot be migrated with migration_strategy = %q while it has serial columns %q: "+
+			"the new table would get new sequences that hand out the copied values again",
+			path, MigrationStrategyCopy, serial)
+	}
+
+	var dependents []string
+	for _, idx := range desc.Indexes {
+		dependents = append(dependents, fmt.Sprintf("index %q", idx.Name))
+	}
+	for _, cf := range desc.Changefeeds {
+		dependents = append(dependents, fmt.Sprintf("changefeed %q", cf.Name))
+	}
+	if len(entry.Permissions) > 0 {
+		dependents = append(dependents, "explicit permissions")
+	}
+	if len(dependents) == 0 {
+		return nil
+	}
+	return fmt.Errorf("table %q cannot be migrated with migration_strategy = %q while it has %s: "+
+		"they would stay on the backup table. Drop them first and create them again after the migration",
+		path, MigrationStrategyCopy, strings.Join(dependents, ", "))
+}
+
+func describeMigrationDependents(ctx context.Context, db *tbl.Driver, path string) (options.Description, scheme.Entry, error) {
+	desc, err := db.DescribeTable(ctx, absolutePath(db, path))
+	if err != nil {
+		return options.Description{}, scheme.Entry{}, fmt.Errorf("failed to describe table %q: %w", path, err)
+	}
+	var entry scheme.Entry
+	err = db.Retry(ctx, "describe path", tbl.Idempotent, func(ctx context.Context) error {
+		entry, err = db.Scheme().DescribePath(ctx, absolutePath(db, path))
+		return err
+	})
+	if err != nil {
+		return options.Description{}, scheme.Entry{}, fmt.Errorf("failed to describe path %q: %w", path, err)
+	}
+	return desc, entry, nil
+}
+
+// ValidateMigrationDependents checks at plan time that a table about to be migrated with the copy
+// strategy has no serial columns, secondary indexes, changefeeds or explicit permissions. The
+// check is skipped while the table cannot be described.
+func ValidateMigrationDependents(ctx context.Context, d *schema.ResourceDiff, authCreds auth.YdbCredentials) error {
+	if d.Id() == "" || !migrationRequired(d) {
+		return nil
+	}
+	entity, err := helpers.ParseYDBEntityID(d.Id())
+	if err != nil {
+		return fmt.Errorf("failed to parse table entity: %w", err)
+	}
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: entity.PrepareFullYDBEndpoint(),
+		AuthCreds:        authCreds,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to initialize table client: %w", err)
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	desc, entry, err := describeMigrationDependents(ctx, db, entity.GetEntityPath())
+	if err != nil {
+		if ydb.IsOperationErrorSchemeError(err) {
+			return nil
+		}
+		return err
+	}
+	return checkMigrationDependents(entity.GetEntityPath(), serialColumns(desc.Columns), desc, entry)
+}
+
+// migrateTable replaces the table at r.Path with a table created from r. The rows are copied in
+// batches, the row counts are compared and the tables are swapped in a single rename operation.
+// The old table is kept at the returned backup path.
+func migrateTable(ctx context.Context, db *tbl.Driver, r *Resource) (string, error) {
+	desc, entry, err := describeMigrationDependents(ctx, db, r.Path)
+	if err != nil {
+		return "", err
+	}
+	if err := checkMigrationDependents(r.Path, serialColumns(desc.Columns), desc, entry); err != nil {
+		return "", err
+	}
+
+	tmpPath, backupPath := migrationPaths(r.Path, time.Now())
+
+	newTable := *r
+	newTable.FullPath = tmpPath
+	createQuery, err := PrepareCreateRequest(&newTable)
+	if err != nil {
+		return "", err
+	}
+	if err := db.ExecuteSchemeQuery(ctx, createQuery, tbl.NonIdempotent); err != nil {
+		return "", fmt.Errorf("failed to create table %q: %w", tmpPath, err)
+	}
+
+	err = copyRows(ctx, db, r.Path, tmpPath)
+	if err == nil {
+		err = verifyRowCounts(ctx, db, r.Path, tmpPath)
+	}
+	if err == nil {
+		err = db.Retry(ctx, "rename tables", tbl.NonIdempotent, func(ctx context.Context) error {
+			return db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+				return s.RenameTables(ctx,
+					options.RenameTablesItem(absolutePath(db, r.Path), absolutePath(db, backupPath), false),
+					options.RenameTablesItem(absolutePath(db, tmpPath), absolutePath(db, r.Path), false),
+				)
+			})
+		})
+	}
+	if err != nil {
+		if dropErr := db.ExecuteSchemeQuery(ctx, PrepareDropTableRequest(tmpPath), tbl.NonIdempotent); dropErr != nil {
+			return "", fmt.Errorf("%w (the temporary table %q was not dropped: %s)", err, tmpPath, dropErr)
+		}
+		return "", err
+	}
+	return backupPath, nil
+}
+
+// copyRows streams the rows of src with the query service and writes them to dst in batches.
+// A retried copy starts over: rows already written are upserted again.
+func copyRows(ctx context.Context, db *tbl.Driver, src, dst string) error {
+	return db.Query().Do(ctx, func(ctx context.Context, s query.Session) error {
+		res, err := s.Query(ctx, prepareSelectAllQuery(src),
+			query.WithTxControl(query.SnapshotReadOnlyTxControl()),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to read table %q: %w", src, err)
+		}
+		defer func() {
+			_ = res.Close(ctx)
+		}()
+
+		batch := make([]types.Value, 0, migrationBatchSize)
+		flush := func() error {
+			if len(batch) == 0 {
+				return nil
+			}
+			rows := types.ListValue(batch...)
+			batch = batch[:0]
+			err := db.Table().BulkUpsert(ctx, absolutePath(db, dst), table.BulkUpsertDataRows(rows))
+			if err != nil {
+				return fmt.Errorf("failed to write table %q: %w", dst, err)
+			}
+			return nil
+		}
+
+		for {
+			rs, err := res.NextResultSet(ctx)
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return fmt.Errorf("failed to read table %q: %w", src, err)
+			}
+			columns := rs.Columns()
+			for {
+				row, err := rs.NextRow(ctx)
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				if err != nil {
+					return fmt.Errorf("failed to read table %q: %w", src, err)
+				}
+				batch = append(batch, rowToStruct(columns, row))
+				if len(batch) == migrationBatchSize {
+					if err := flush(); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return flush()
+	})
+}
+
+func rowToStruct(columns []string, row query.Row) types.Value {
+	values := row.Values()
+	fields := make([]types.StructValueOption, 0, len(values))
+	for i, v := range values {
+		fields = append(fields, types.StructFieldValue(columns[i], v))
+	}
+	return types.StructValue(fields...)
+}
+
+func verifyRowCounts(ctx context.Context, db *tbl.Driver, src, dst string) error {
+	srcCount, err := countRows(ctx, db, src)
+	if err != nil {
+		return err
+	}
+	dstCount, err := countRows(ctx, db, dst)
+	if err != nil {
+		return err
+	}
+	if srcCount != dstCount {
+		return fmt.Errorf("row count mismatch after copying %q to %q: %d != %d, was the table written to during the migration?",
+			src, dst, srcCount, dstCount)
+	}
+	return nil
+}
+
+func countRows(ctx context.Context, db *tbl.Driver, path string) (uint64, error) {
+	row, err := db.Query().QueryRow(ctx, prepareCountRowsQuery(path))
+	if err != nil {
+		return 0, fmt.Errorf("failed to count rows of %q: %w", path, err)
+	}
+	var count uint64
+	if err := row.Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count rows of %q: %w", path, err)
+	}
+	return count, nil
+}
+
+// dropBackups drops the backup tables toDrop and returns the backups that are left. Backups
+// that no longer exist are considered dropped.
+func dropBackups(ctx context.Context, db *tbl.Driver, backups, toDrop []*MigrationBackup) ([]*MigrationBackup, error) {
+	dropped := make(map[*MigrationBackup]bool, len(toDrop))
+	var errs []error
+	for _, b := range toDrop {
+		err := db.ExecuteSchemeQuery(ctx, PrepareDropTableRequest(b.Path), tbl.NonIdempotent)
+		if err != nil && !ydb.IsOperationErrorSchemeError(err) {
+			errs = append(errs, fmt.Errorf("failed to drop backup table %q: %w", b.Path, err))
+			continue
+		}
+		dropped[b] = true
+	}
+	left := make([]*MigrationBackup, 0, len(backups))
+	for _, b := range backups {
+		if !dropped[b] {
+			left = append(left, b)
+		}
+	}
+	return left, errors.Join(errs...)
+}
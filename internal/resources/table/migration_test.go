@@ -0,0 +1,69 @@
+package table
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+)
+
+func TestMigrationPaths(t *testing.T) {
+	now := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	tmpPath, backupPath := migrationPaths("dir/events", now)
+	assert.Equal(t, "dir/events_tf_migration_20240506070809", tmpPath)
+	assert.Equal(t, "dir/events_tf_backup_20240506070809", backupPath)
+}
+
+func TestMigrationBackups(t *testing.T) {
+	now := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	backups := []*MigrationBackup{
+		{Path: "a_tf_backup_1", ExpiresAt: now.Add(-time.Hour)},
+		{Path: "a_tf_backup_2", ExpiresAt: now.Add(time.Hour)},
+		{Path: "a_tf_backup_3"},
+	}
+
+	flat := flattenMigrationBackups(backups)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"path": "a_tf_backup_1", "expires_at": "2024-05-06T06:08:09Z"},
+		map[string]interface{}{"path": "a_tf_backup_2", "expires_at": "2024-05-06T08:08:09Z"},
+		map[string]interface{}{"path": "a_tf_backup_3", "expires_at": ""},
+	}, flat)
+
+	expanded := expandMigrationBackups(flat)
+	assert.Len(t, expanded, 3)
+	for i := range backups {
+		assert.Equal(t, backups[i].Path, expanded[i].Path)
+		assert.True(t, backups[i].ExpiresAt.Equal(expanded[i].ExpiresAt))
+	}
+
+	expired := expiredBackups(expanded, now)
+	assert.Len(t, expired, 1)
+	assert.Equal(t, "a_tf_backup_1", expired[0].Path)
+	assert.Empty(t, expiredBackups(expanded, now.Add(-2*time.Hour)))
+}
+
+func TestPrepareMigrationQueries(t *testing.T) {
+	assert.Equal(t, "SELECT * FROM `dir\\/events`", prepareSelectAllQuery("dir/events"))
+	assert.Equal(t, "SELECT COUNT(*) FROM `dir\\/events`", prepareCountRowsQuery("dir/events"))
+}
+
+func TestCheckMigrationDependents(t *testing.T) {
+	assert.NoError(t, checkMigrationDependents("dir/events", nil, options.Description{}, scheme.Entry{}))
+
+	err := checkMigrationDependents("dir/events", nil, options.Description{
+		Indexes:     []options.IndexDescription{{Name: "by_ts"}},
+		Changefeeds: []options.ChangefeedDescription{{Name: "updates"}},
+	}, scheme.Entry{
+		Permissions: []scheme.Permissions{{Subject: "reader", PermissionNames: []string{"ydb.generic.read"}}},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `index "by_ts", changefeed "updates", explicit permissions`)
+
+	err = checkMigrationDependents("dir/events", []string{"id"}, options.Description{}, scheme.Entry{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `serial columns ["id"]`)
+	assert.Empty(t, serialColumns([]options.Column{{Name: "name"}}))
+}
@@ -0,0 +1,252 @@
+package table
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+// nonKeyTypes are the column types YDB does not accept in a primary key.
+var nonKeyTypes = map[string]bool{
+	"json":         true,
+	"jsondocument": true,
+	"yson":         true,
+}
+
+// parsePartitionKey converts the textual value of a primary key column of type typ into a typed
+// YDB value.
+func parsePartitionKey(raw, typ string) (types.Value, error) {
+	typ = storageType(typ)
+	if nonKeyTypes[strings.ToLower(typ)] {
+		return nil, fmt.Errorf("columns of type %s cannot be primary key columns", typ)
+	}
+	v, err := parseLiteral(typ, raw)
+	if errors.Is(err, errUnsupportedLiteralType) {
+		return nil, fmt.Errorf("unsupported primary key type %s", typ)
+	}
+	return v, err
+}
+
+// partitionKeyValues returns the textual values of a partition_at_keys element, nil standing for
+// NULL, and the name of the attribute they are set with. The values are given either as a keys
+// list or as key blocks, which can also set NULL.
+func partitionKeyValues(i int, m map[string]interface{}) ([]*string, string, error) {
+	keys, _ := m["keys"].([]interface{})
+	blocks, _ := m["key"].([]interface{})
+	switch {
+	case len(keys) > 0 && len(blocks) > 0:
+		return nil, "", fmt.Errorf("partition_at_keys[%d]: set either keys or key blocks", i)
+	case len(keys) == 0 && len(blocks) == 0:
+		return nil, "", fmt.Errorf("partition_at_keys[%d]: keys or key blocks are required", i)
+	}
+
+	values := make([]*string, 0, len(keys)+len(blocks))
+	for _, k := range keys {
+		s, _ := k.(string)
+		values = append(values, &s)
+	}
+	if len(keys) > 0 {
+		return values, "keys", nil
+	}
+	for j, b := range blocks {
+		key, _ := b.(map[string]interface{})
+		s, _ := key["value"].(string)
+		if null, _ := key["null"].(bool); null {
+			if s != "" {
+				return nil, "", fmt.Errorf("partition_at_keys[%d].key[%d]: value cannot be set together with null = true", i, j)
+			}
+			values = append(values, nil)
+			continue
+		}
+		values = append(values, &s)
+	}
+	return values, "key", nil
+}
+
+// expandPartitionAtKeys parses the partition_at_keys split points. The values of each split point
+// belong to the primary key columns in the primary key order and are parsed with the types of
+// these columns. A split point can set a prefix of the primary key only.
+func expandPartitionAtKeys(p []interface{}, columns []*Column, primaryKey []string) ([]*PartitionAtKeys, error) {
+	if len(p) == 0 || len(primaryKey) == 0 {
+		return nil, nil
+	}
+	byName := make(map[string]*Column, len(columns))
+	for _, c := range columns {
+		byName[c.Name] = c
+	}
+
+	res := make([]*PartitionAtKeys, 0, len(p))
+	for i, v := range p {
+		m, _ := v.(map[string]interface{})
+		values, attr, err := partitionKeyValues(i, m)
+		if err != nil {
+			return nil, err
+		}
+		if len(values) > len(primaryKey) {
+			return nil, fmt.Errorf("partition_at_keys[%d]: %d keys for a primary key of %d columns", i, len(values), len(primaryKey))
+		}
+		pp := &PartitionAtKeys{Keys: make([]types.Value, 0, len(values))}
+		for j, raw := range values {
+			c, ok := byName[primaryKey[j]]
+			if !ok {
+				return nil, fmt.Errorf("partition_at_keys[%d].%s[%d]: primary key column %q is not declared", i, attr, j, primaryKey[j])
+			}
+			if raw == nil {
+				if c.NotNull {
+					return nil, fmt.Errorf("partition_at_keys[%d].%s[%d]: NULL for column %q declared with not_null", i, attr, j, c.Name)
+				}
+				pp.Keys = append(pp.Keys, nil)
+				continue
+			}
+			key, err := parsePartitionKey(*raw, c.Type)
+			if err != nil {
+				return nil, fmt.Errorf("partition_at_keys[%d].%s[%d] %q for column %q of type %s: %w", i, attr, j, *raw, c.Name, c.Type, err)
+			}
+			pp.Keys = append(pp.Keys, key)
+		}
+		res = append(res, pp)
+	}
+	return res, nil
+}
+
+// keyLiteral renders a split point value as a YQL literal. Optional values are unwrapped, so
+// configured and described values render the same.
+func keyLiteral(v types.Value) string {
+	if v != nil {
+		v = types.Unwrap(v)
+	}
+	if v == nil {
+		return "NULL"
+	}
+	return v.Yql()
+}
+
+// trimNullKeys drops the trailing NULL values of a split point: NULL is the smallest value of a
+// column, so a split point with trailing NULLs is the same as its prefix.
+func trimNullKeys(keys []types.Value) []types.Value {
+	for len(keys) > 0 && keyLiteral(keys[len(keys)-1]) == "NULL" {
+		keys = keys[:len(keys)-1]
+	}
+	return keys
+}
+
+func samePartitionAtKeys(a, b []*PartitionAtKeys) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		ka, kb := trimNullKeys(a[i].Keys), trimNullKeys(b[i].Keys)
+		if len(ka) != len(kb) {
+			return false
+		}
+		for j := range ka {
+			if keyLiteral(ka[j]) != keyLiteral(kb[j]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// describedPartitionAtKeys returns the split points of a table from its shard key bounds: the
+// left bound of every partition except the first one.
+func describedPartitionAtKeys(ranges []options.KeyRange) ([]*PartitionAtKeys, error) {
+	if len(ranges) < 2 {
+		return nil, nil
+	}
+	res := make([]*PartitionAtKeys, 0, len(ranges)-1)
+	for _, r := range ranges[1:] {
+		if r.From == nil {
+			return nil, fmt.Errorf("partition %s has no left bound", r)
+		}
+		keys, err := types.TupleItems(r.From)
+		if err != nil {
+			keys = []types.Value{r.From}
+		}
+		res = append(res, &PartitionAtKeys{Keys: keys})
+	}
+	return res, nil
+}
+
+// flattenPartitionAtKeys converts split points to partition_at_keys. Split points with NULL
+// values are flattened to key blocks, the other ones to keys lists.
+func flattenPartitionAtKeys(points []*PartitionAtKeys) []interface{} {
+	res := make([]interface{}, 0, len(points))
+	for _, p := range points {
+		keys := trimNullKeys(p.Keys)
+		values := make([]interface{}, 0, len(keys))
+		blocks := make([]interface{}, 0, len(keys))
+		hasNull := false
+		for _, k := range keys {
+			if keyLiteral(k) == "NULL" {
+				hasNull = true
+				blocks = append(blocks, map[string]interface{}{"value": "", "null": true})
+				continue
+			}
+			s := flattenDefault(types.Unwrap(k))
+			values = append(values, s)
+			blocks = append(blocks, map[string]interface{}{"value": s, "null": false})
+		}
+		if hasNull {
+			values = []interface{}{}
+		} else {
+			blocks = []interface{}{}
+		}
+		res = append(res, map[string]interface{}{
+			"keys": values,
+			"key":  blocks,
+		})
+	}
+	return res
+}
+
+// readPartitionAtKeys compares the configured split points with the shard key bounds of the
+// table and keeps the configured spelling when they are the same. The bounds are compared only
+// while auto partitioning is disabled: otherwise YDB splits and merges partitions on its own.
+func readPartitionAtKeys(
+	configured []interface{},
+	columns []*Column,
+	primaryKey []string,
+	settings options.PartitioningSettings,
+	ranges []options.KeyRange,
+) []interface{} {
+	if len(configured) == 0 || len(ranges) == 0 ||
+		settings.PartitioningBySize == options.FeatureEnabled ||
+		settings.PartitioningByLoad == options.FeatureEnabled {
+		return configured
+	}
+	described, err := describedPartitionAtKeys(ranges)
+	if err != nil {
+		return configured
+	}
+	expanded, err := expandPartitionAtKeys(configured, columns, primaryKey)
+	if err == nil && samePartitionAtKeys(expanded, described) {
+		return configured
+	}
+	return flattenPartitionAtKeys(described)
+}
+
+// ValidateResourceDiffPartitionAtKeys parses the partition_at_keys split points with the types
+// of the primary key columns at plan time, so a malformed key fails the plan instead of the
+// CREATE TABLE query.
+func ValidateResourceDiffPartitionAtKeys(d *schema.ResourceDiff) error {
+	if d.Id() != "" && !d.HasChange("partitioning_settings.0.partition_at_keys") &&
+		!d.HasChange("primary_key") && !d.HasChange("column") {
+		return nil
+	}
+	if !d.NewValueKnown("column") || !d.NewValueKnown("primary_key") || !d.NewValueKnown("partitioning_settings") {
+		return nil
+	}
+	settings, _ := d.Get("partitioning_settings").([]interface{})
+	if len(settings) == 0 {
+		return nil
+	}
+	m, _ := settings[0].(map[string]interface{})
+	keys, _ := m["partition_at_keys"].([]interface{})
+	_, err := expandPartitionAtKeys(keys, expandColumns(d.Get("column")), expandStrings(d.Get("primary_key")))
+	return err
+}
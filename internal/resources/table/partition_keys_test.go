@@ -0,0 +1,185 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+func TestParsePartitionKey(t *testing.T) {
+	testData := []struct {
+		typ           string
+		raw           string
+		expected      string
+		expectedError bool
+	}{
+		{typ: "Uint64", raw: "10", expected: "10ul"},
+		{typ: "Int32", raw: "-3", expected: "-3"},
+		{typ: "Serial", raw: "7", expected: "7"},
+		{typ: "Bool", raw: "true", expected: "true"},
+		{typ: "Utf8", raw: "a\"b", expected: `"a\"b"u`},
+		{typ: "String", raw: "x", expected: `"x"`},
+		{typ: "Decimal(22,9)", raw: "1.5", expected: `Decimal("1.500000000",22,9)`},
+		{typ: "Date", raw: "2024-01-02", expected: `Date("2024-01-02")`},
+		{typ: "Datetime", raw: "2024-01-02T03:04:05Z", expected: `Datetime("2024-01-02T03:04:05Z")`},
+		{typ: "Timestamp", raw: "2024-01-02T03:04:05+01:00", expected: `Timestamp("2024-01-02T02:04:05.000000Z")`},
+		{typ: "Date32", raw: "1969-12-27", expected: `Date32("1969-12-27")`},
+		{typ: "Datetime64", raw: "1969-12-31T23:59:55Z", expected: `Datetime64("1969-12-31T23:59:55Z")`},
+		{typ: "Timestamp64", raw: "1970-01-01T00:00:00.000005Z", expected: `Timestamp64("1970-01-01T00:00:00.000005Z")`},
+		{typ: "Interval", raw: "1s", expected: `Interval("PT1.000000S")`},
+		{typ: "Uuid", raw: "6BA7B810-9DAD-11D1-80B4-00C04FD430C8", expected: `Uuid("6ba7b810-9dad-11d1-80b4-00c04fd430c8")`},
+		{typ: "DyNumber", raw: "1.5", expected: `DyNumber("1.5")`},
+		{typ: "Uint8", raw: "300", expectedError: true},
+		{typ: "Uuid", raw: "not-a-uuid", expectedError: true},
+		{typ: "Timestamp", raw: "2024-01-02", expectedError: true},
+		{typ: "JsonDocument", raw: "{}", expectedError: true},
+		{typ: "Unknown", raw: "1", expectedError: true},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.typ+"/"+v.raw, func(t *testing.T) {
+			got, err := parsePartitionKey(v.raw, v.typ)
+			if v.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, v.expected, got.Yql())
+		})
+	}
+}
+
+func TestExpandPartitionAtKeys(t *testing.T) {
+	columns := []*Column{
+		{Name: "id", Type: "Uint64"},
+		{Name: "ts", Type: "Timestamp", NotNull: true},
+		{Name: "name", Type: "Utf8"},
+	}
+	primaryKey := []string{"ts", "name", "id"}
+
+	keys, err := expandPartitionAtKeys([]interface{}{
+		map[string]interface{}{"keys": []interface{}{"2024-01-01T00:00:00Z"}},
+		map[string]interface{}{"key": []interface{}{
+			map[string]interface{}{"value": "2024-02-01T00:00:00Z"},
+			map[string]interface{}{"null": true},
+			map[string]interface{}{"value": "10"},
+		}},
+	}, columns, primaryKey)
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	assert.Equal(t, []string{`Timestamp("2024-01-01T00:00:00.000000Z")`}, keyLiterals(keys[0]))
+	assert.Equal(t, []string{`Timestamp("2024-02-01T00:00:00.000000Z")`, "NULL", "10ul"}, keyLiterals(keys[1]))
+
+	testData := []struct {
+		name     string
+		keys     map[string]interface{}
+		errorMsg string
+	}{
+		{
+			name:     "invalid value",
+			keys:     map[string]interface{}{"keys": []interface{}{"2024-01-01T00:00:00Z", "a", "x"}},
+			errorMsg: `partition_at_keys[0].keys[2] "x" for column "id" of type Uint64`,
+		},
+		{
+			name:     "too many keys",
+			keys:     map[string]interface{}{"keys": []interface{}{"2024-01-01T00:00:00Z", "a", "1", "2"}},
+			errorMsg: "partition_at_keys[0]: 4 keys for a primary key of 3 columns",
+		},
+		{
+			name:     "null for not null column",
+			keys:     map[string]interface{}{"key": []interface{}{map[string]interface{}{"null": true}}},
+			errorMsg: `partition_at_keys[0].key[0]: NULL for column "ts" declared with not_null`,
+		},
+		{
+			name: "null with value",
+			keys: map[string]interface{}{"key": []interface{}{
+				map[string]interface{}{"value": "2024-01-01T00:00:00Z"},
+				map[string]interface{}{"value": "a", "null": true},
+			}},
+			errorMsg: "partition_at_keys[0].key[1]: value cannot be set together with null = true",
+		},
+		{
+			name: "keys and key blocks",
+			keys: map[string]interface{}{
+				"keys": []interface{}{"2024-01-01T00:00:00Z"},
+				"key":  []interface{}{map[string]interface{}{"value": "2024-01-01T00:00:00Z"}},
+			},
+			errorMsg: "partition_at_keys[0]: set either keys or key blocks",
+		},
+		{
+			name:     "no keys",
+			keys:     map[string]interface{}{},
+			errorMsg: "partition_at_keys[0]: keys or key blocks are required",
+		},
+	}
+	for _, v := range testData {
+		v := v
+		t.Run(v.name, func(t *testing.T) {
+			_, err := expandPartitionAtKeys([]interface{}{v.keys}, columns, primaryKey)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), v.errorMsg)
+		})
+	}
+}
+
+func TestReadPartitionAtKeys(t *testing.T) {
+	columns := []*Column{
+		{Name: "a", Type: "Uint64"},
+		{Name: "b", Type: "Decimal(22,9)"},
+	}
+	primaryKey := []string{"a", "b"}
+	bound := func(a types.Value, b types.Value) types.Value {
+		return types.TupleValue(a, b)
+	}
+	ranges := []options.KeyRange{
+		{To: bound(types.OptionalValue(types.Uint64Value(10)), types.NullValue(types.DecimalType(22, 9)))},
+		{
+			From: bound(types.OptionalValue(types.Uint64Value(10)), types.NullValue(types.DecimalType(22, 9))),
+			To:   bound(types.NullValue(types.TypeUint64), types.OptionalValue(mustDecimal(t, "1.5"))),
+		},
+		{From: bound(types.NullValue(types.TypeUint64), types.OptionalValue(mustDecimal(t, "1.5")))},
+	}
+	configured := []interface{}{
+		map[string]interface{}{"keys": []interface{}{"10"}, "key": []interface{}{}},
+		map[string]interface{}{"keys": []interface{}{}, "key": []interface{}{
+			map[string]interface{}{"value": "", "null": true},
+			map[string]interface{}{"value": "1.50", "null": false},
+		}},
+	}
+
+	got := readPartitionAtKeys(configured, columns, primaryKey, options.PartitioningSettings{}, ranges)
+	assert.Equal(t, configured, got, "the same split points keep the configured spelling")
+
+	drifted := readPartitionAtKeys(configured[:1], columns, primaryKey, options.PartitioningSettings{}, ranges)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"keys": []interface{}{"10"}, "key": []interface{}{}},
+		map[string]interface{}{"keys": []interface{}{}, "key": []interface{}{
+			map[string]interface{}{"value": "", "null": true},
+			map[string]interface{}{"value": "1.500000000", "null": false},
+		}},
+	}, drifted)
+
+	autoPartitioned := readPartitionAtKeys(configured[:1], columns, primaryKey, options.PartitioningSettings{
+		PartitioningBySize: options.FeatureEnabled,
+	}, ranges)
+	assert.Equal(t, configured[:1], autoPartitioned, "split points of auto partitioned tables are kept as configured")
+}
+
+func keyLiterals(p *PartitionAtKeys) []string {
+	res := make([]string, 0, len(p.Keys))
+	for _, k := range p.Keys {
+		res = append(res, keyLiteral(k))
+	}
+	return res
+}
+
+func mustDecimal(t *testing.T, s string) types.Value {
+	t.Helper()
+	v, err := types.DecimalValueFromString(s, 22, 9)
+	require.NoError(t, err)
+	return v
+}
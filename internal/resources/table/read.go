@@ -9,6 +9,7 @@ import (
 	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
 
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
 	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
 )
 
@@ -63,5 +64,19 @@ func (h *handler) Read(ctx context.Context, d *schema.ResourceData, cfg interfac
 		return diag.Errorf("failed to describe table %q: %s", tableResource.Path, err)
 	}
 
-	return diag.FromErr(flattenTableDescription(d, description, tableResource.Entity))
+	if err = flattenTableDescription(d, description, tableResource.Entity); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if description.StoreType == options.StoreTypeColumn {
+		store, err := parentTablestore(ctx, db, tableResource.Entity)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if helpers.TrimPath(d.Get("tablestore").(string)) != store {
+			_ = d.Set("tablestore", store)
+		}
+	}
+
+	return nil
 }
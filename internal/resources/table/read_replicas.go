@@ -0,0 +1,173 @@
+package table
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+)
+
+const (
+	// ReadReplicasModePerAZ places the read replicas in every availability zone.
+	ReadReplicasModePerAZ = "PER_AZ"
+	// ReadReplicasModeAnyAZ places the read replicas in any availability zones.
+	ReadReplicasModeAnyAZ = "ANY_AZ"
+)
+
+// ReadReplicas are the read replicas settings of a row table.
+type ReadReplicas struct {
+	Mode  string
+	Count uint64
+}
+
+// String returns the settings in the READ_REPLICAS_SETTINGS form, e.g. PER_AZ:1.
+func (r *ReadReplicas) String() string {
+	return r.Mode + ":" + strconv.FormatUint(r.Count, 10)
+}
+
+// ParseReadReplicas parses settings in the READ_REPLICAS_SETTINGS form. The mode is case
+// insensitive.
+func ParseReadReplicas(s string) (*ReadReplicas, error) {
+	mode, count, ok := strings.Cut(strings.TrimSpace(s), ":")
+	if !ok {
+		return nil, fmt.Errorf("read replicas settings %q must be in the form MODE:COUNT, e.g. PER_AZ:1", s)
+	}
+	mode = strings.ToUpper(strings.TrimSpace(mode))
+	if mode != ReadReplicasModePerAZ && mode != ReadReplicasModeAnyAZ {
+		return nil, fmt.Errorf("read replicas mode %q must be %s or %s", mode, ReadReplicasModePerAZ, ReadReplicasModeAnyAZ)
+	}
+	n, err := strconv.ParseUint(strings.TrimSpace(count), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("read replicas count %q must be a non-negative integer", count)
+	}
+	return &ReadReplicas{Mode: mode, Count: n}, nil
+}
+
+// sameReadReplicasSettings reports whether two READ_REPLICAS_SETTINGS strings are the same
+// settings, e.g. "per_az:1" and "PER_AZ:1".
+func sameReadReplicasSettings(a, b string) bool {
+	if a == b {
+		return true
+	}
+	ra, err := ParseReadReplicas(a)
+	if err != nil {
+		return false
+	}
+	rb, err := ParseReadReplicas(b)
+	if err != nil {
+		return false
+	}
+	return *ra == *rb
+}
+
+// readReplicasSettingsChanged reports whether read_replicas_settings describes other settings
+// than before. Unlike HasChange, it ignores changes in spelling.
+func readReplicasSettingsChanged(d interface {
+	GetChange(string) (interface{}, interface{})
+}) bool {
+	o, n := d.GetChange("read_replicas_settings")
+	return !sameReadReplicasSettings(o.(string), n.(string))
+}
+
+// SuppressReadReplicasSettingsDiff suppresses the diff of read_replicas_settings strings that
+// describe the same settings.
+func SuppressReadReplicasSettingsDiff(_, oldValue, newValue string, _ *schema.ResourceData) bool {
+	return sameReadReplicasSettings(oldValue, newValue)
+}
+
+// ValidateReadReplicasSettings checks the deprecated read_replicas_settings string.
+func ValidateReadReplicasSettings(i interface{}, k string) ([]string, []error) {
+	s, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+	if s == "" {
+		return nil, nil
+	}
+	if _, err := ParseReadReplicas(s); err != nil {
+		return nil, []error{fmt.Errorf("%q: %w", k, err)}
+	}
+	return nil, nil
+}
+
+// expandReadReplicas returns the configured read replicas settings. The read_replicas block and
+// the deprecated read_replicas_settings string describe the same settings: the string is used
+// when it is set on creation or changed.
+func expandReadReplicas(d *schema.ResourceData) (*ReadReplicas, error) {
+	if s := d.Get("read_replicas_settings").(string); s != "" && (d.Id() == "" || readReplicasSettingsChanged(d)) {
+		return ParseReadReplicas(s)
+	}
+	return expandReadReplicasBlock(d.Get("read_replicas")), nil
+}
+
+func expandReadReplicasBlock(raw interface{}) *ReadReplicas {
+	list, _ := raw.([]interface{})
+	if len(list) == 0 {
+		return nil
+	}
+	m, ok := list[0].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	count, _ := m["count"].(int)
+	return &ReadReplicas{
+		Mode:  m["mode"].(string),
+		Count: uint64(count),
+	}
+}
+
+// flattenReadReplicas converts the described settings, returning nil for a table without read
+// replicas.
+func flattenReadReplicas(settings options.ReadReplicasSettings) *ReadReplicas {
+	if settings.Count == 0 {
+		return nil
+	}
+	switch settings.Type {
+	case options.ReadReplicasPerAzReadReplicas:
+		return &ReadReplicas{Mode: ReadReplicasModePerAZ, Count: settings.Count}
+	case options.ReadReplicasAnyAzReadReplicas:
+		return &ReadReplicas{Mode: ReadReplicasModeAnyAZ, Count: settings.Count}
+	}
+	return nil
+}
+
+func flattenReadReplicasBlock(r *ReadReplicas) []interface{} {
+	if r == nil {
+		return []interface{}{}
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"mode":  r.Mode,
+			"count": int(r.Count),
+		},
+	}
+}
+
+// ValidateResourceDiffReadReplicas checks the read replicas settings at plan time. The
+// read_replicas block and the deprecated read_replicas_settings string describe the same
+// settings, so when one of them changes the other one is recomputed.
+func ValidateResourceDiffReadReplicas(d *schema.ResourceDiff) error {
+	configured := false
+	if s, _ := d.Get("read_replicas_settings").(string); s != "" && d.NewValueKnown("read_replicas_settings") {
+		configured = true
+	}
+	if len(d.Get("read_replicas").([]interface{})) > 0 {
+		configured = true
+	}
+	changed := d.HasChange("read_replicas") || readReplicasSettingsChanged(d)
+	if configured && (d.Id() == "" || changed) && strings.EqualFold(d.Get("store").(string), "column") {
+		return fmt.Errorf("read replicas are supported for row tables only")
+	}
+	if d.Id() == "" {
+		return nil
+	}
+	switch {
+	case readReplicasSettingsChanged(d):
+		return d.SetNewComputed("read_replicas")
+	case d.HasChange("read_replicas"):
+		return d.SetNewComputed("read_replicas_settings")
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+)
+
+func TestParseReadReplicas(t *testing.T) {
+	r, err := ParseReadReplicas(" per_az : 2 ")
+	require.NoError(t, err)
+	assert.Equal(t, &ReadReplicas{Mode: ReadReplicasModePerAZ, Count: 2}, r)
+	assert.Equal(t, "PER_AZ:2", r.String())
+
+	for _, s := range []string{"PER_AZ", "ALL_AZ:1", "ANY_AZ:-1", "ANY_AZ:x"} {
+		_, err := ParseReadReplicas(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestSameReadReplicasSettings(t *testing.T) {
+	assert.True(t, sameReadReplicasSettings("any_az:1", "ANY_AZ:1"))
+	assert.False(t, sameReadReplicasSettings("ANY_AZ:1", "PER_AZ:1"))
+	assert.False(t, sameReadReplicasSettings("ANY_AZ:1", ""))
+}
+
+func TestFlattenReadReplicas(t *testing.T) {
+	assert.Nil(t, flattenReadReplicas(options.ReadReplicasSettings{}))
+	assert.Equal(t, &ReadReplicas{Mode: ReadReplicasModeAnyAZ, Count: 3}, flattenReadReplicas(options.ReadReplicasSettings{
+		Type:  options.ReadReplicasAnyAzReadReplicas,
+		Count: 3,
+	}))
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"mode": ReadReplicasModePerAZ, "count": 1},
+	}, flattenReadReplicasBlock(&ReadReplicas{Mode: ReadReplicasModePerAZ, Count: 1}))
+}
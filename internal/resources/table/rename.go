@@ -0,0 +1,51 @@
+package table
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+// ValidateResourceDiffRename forces a new table when the path changes, unless renames are allowed.
+// A renamed table gets a new ID, so the ID is planned as unknown: resources referencing it, such
+// as indexes and changefeeds, follow the table to its new path.
+func ValidateResourceDiffRename(d *schema.ResourceDiff) error {
+	if d.Id() == "" || !d.HasChange("path") {
+		return nil
+	}
+	if !d.Get("allow_rename").(bool) {
+		return d.ForceNew("path")
+	}
+	return d.SetNewComputed("id")
+}
+
+// renameTable moves the table from oldPath to newPath with a single rename operation. The
+// indexes and changefeeds of the table are moved with it.
+func renameTable(ctx context.Context, db *tbl.Driver, oldPath, newPath string) error {
+	return db.Retry(ctx, "rename table", tbl.NonIdempotent, func(ctx context.Context) error {
+		return db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+			return s.RenameTables(ctx,
+				options.RenameTablesItem(absolutePath(db, oldPath), absolutePath(db, newPath), false),
+			)
+		})
+	})
+}
+
+// renameIfRequired renames the table when its path has changed and points the resource ID to
+// the new path.
+func renameIfRequired(ctx context.Context, db *tbl.Driver, d *schema.ResourceData, r *Resource) error {
+	if !d.HasChange("path") || r.Entity == nil {
+		return nil
+	}
+	oldPath := r.Entity.GetEntityPath()
+	if err := renameTable(ctx, db, oldPath, r.Path); err != nil {
+		return fmt.Errorf("failed to rename table %q to %q: %w", oldPath, r.Path, err)
+	}
+	d.SetId(r.DatabaseEndpoint + "?path=" + r.Path)
+	return nil
+}
@@ -19,9 +19,12 @@ type Column struct {
 	Type    string
 	Family  string
 	NotNull bool
+	Default string
 }
 
-func (c *Column) ToYQL() string {
+// ToYQL renders the column definition. It fails when the default is not a literal of the
+// column type; defaults are also validated at plan time, see ValidateResourceDiffColumns.
+func (c *Column) ToYQL() (string, error) {
 	buf := make([]byte, 0, 128)
 	buf = append(buf, '`')
 	buf = helpers.AppendWithEscape(buf, c.Name)
@@ -38,7 +41,15 @@ func (c *Column) ToYQL() string {
 	if c.NotNull {
 		buf = append(buf, " NOT NULL"...)
 	}
-	return string(buf)
+	if c.Default != "" {
+		literal, err := defaultToYQL(c)
+		if err != nil {
+			return "", err
+		}
+		buf = append(buf, " DEFAULT "...)
+		buf = append(buf, literal...)
+	}
+	return string(buf), nil
 }
 
 type PrimaryKey struct {
@@ -56,14 +67,40 @@ type TTL struct {
 	ColumnName     string
 	ExpireInterval string
 	Unit           string
+	// Tiers replace ExpireInterval for tiered TTL. Rows move to the external data source of
+	// each tier in turn; the last tier may delete them instead.
+	Tiers []TTLTier
+}
+
+// TTLTier is a single tier of a tiered TTL. An empty ExternalDataSource deletes expired rows.
+type TTLTier struct {
+	ExpireInterval     string
+	ExternalDataSource string
+}
+
+func (t *TTLTier) isDelete() bool {
+	return t.ExternalDataSource == ""
 }
 
 func (t *TTL) ToYQL() string {
 	buf := make([]byte, 0, 64)
-	buf = append(buf, "TTL = Interval(\""...)
-	buf = helpers.AppendWithEscape(buf, t.ExpireInterval)
-	buf = append(buf, '"')
-	buf = append(buf, ')')
+	buf = append(buf, "TTL = "...)
+	if len(t.Tiers) == 0 {
+		buf = appendTTLInterval(buf, t.ExpireInterval)
+	}
+	for i, tier := range t.Tiers {
+		if i > 0 {
+			buf = append(buf, ',', ' ')
+		}
+		buf = appendTTLInterval(buf, tier.ExpireInterval)
+		if tier.isDelete() {
+			buf = append(buf, " DELETE"...)
+		} else {
+			buf = append(buf, " TO EXTERNAL DATA SOURCE `"...)
+			buf = helpers.AppendWithEscape(buf, tier.ExternalDataSource)
+			buf = append(buf, '`')
+		}
+	}
 	buf = append(buf, " ON "...)
 	buf = append(buf, '`')
 	buf = helpers.AppendWithEscape(buf, t.ColumnName)
@@ -75,8 +112,17 @@ func (t *TTL) ToYQL() string {
 	return string(buf)
 }
 
+func appendTTLInterval(buf []byte, interval string) []byte {
+	buf = append(buf, "Interval(\""...)
+	buf = helpers.AppendWithEscape(buf, interval)
+	buf = append(buf, '"', ')')
+	return buf
+}
+
+// PartitionAtKeys is a split point of the table: the values of a prefix of the primary key
+// columns in the primary key order. A nil value is NULL.
 type PartitionAtKeys struct {
-	Keys []interface{}
+	Keys []types.Value
 }
 
 type PartitioningSettings struct {
@@ -91,13 +137,14 @@ type PartitioningSettings struct {
 }
 
 type ReplicationSettings struct {
-	ReadReplicasSettings string
+	ReadReplicas *ReadReplicas
 }
 
 type Family struct {
-	Name        string
-	Data        string
-	Compression string
+	Name             string
+	Data             string
+	Compression      string
+	CompressionLevel int
 }
 
 type Resource struct {
@@ -152,44 +199,60 @@ func expandTableTTLSettings(d *schema.ResourceData) (ttl *TTL) {
 		//		ttl.Mode = m["mode"].(string)
 		ttl.ExpireInterval = m["expire_interval"].(string)
 		ttl.Unit = m["unit"].(string)
+		ttl.Tiers = expandTTLTiers(m["tier"])
 	}
 	return
 }
 
-func expandTableReplicasSettings(d *schema.ResourceData) (p *ReplicationSettings) {
-	v, ok := d.GetOk("read_replicas_settings")
-	if !ok {
-		return
+// flattenTTLTiers returns the TTL tiers to store in the state. The table description reports
+// a tiered TTL only when it consists of a single delete tier, whose interval is then taken from
+// the description; other tiers are kept as configured, so their drift goes unnoticed and an
+// imported table has none.
+//
+// TODO: read the tiers from Ydb_Table.TtlSettings of the raw DescribeTable result once
+// ydb-go-genproto has them (v0.0.0-20260810123728 only describes the delete rule).
+func flattenTTLTiers(configured []TTLTier, describedInterval string) []interface{} {
+	tiers := make([]interface{}, 0, len(configured))
+	for _, tier := range configured {
+		interval := tier.ExpireInterval
+		if len(configured) == 1 && tier.isDelete() && describedInterval != "" {
+			if d, err := iso8601ToTTL(interval); err != nil || ttlToISO8601(d) != describedInterval {
+				interval = describedInterval
+			}
+		}
+		tiers = append(tiers, map[string]interface{}{
+			"expire_interval":      interval,
+			"external_data_source": tier.ExternalDataSource,
+		})
 	}
-
-	p = &ReplicationSettings{}
-	p.ReadReplicasSettings = v.(string)
-	return
+	return tiers
 }
 
-func expandPartitionAtKeys(p []interface{}, primaryKeyColumns []*Column) ([]*PartitionAtKeys, error) {
-	if len(p) == 0 || len(primaryKeyColumns) == 0 {
-		return nil, nil
+func expandTTLTiers(raw interface{}) []TTLTier {
+	tiersRaw, _ := raw.([]interface{})
+	tiers := make([]TTLTier, 0, len(tiersRaw))
+	for _, v := range tiersRaw {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		tiers = append(tiers, TTLTier{
+			ExpireInterval:     m["expire_interval"].(string),
+			ExternalDataSource: m["external_data_source"].(string),
+		})
 	}
+	if len(tiers) == 0 {
+		return nil
+	}
+	return tiers
+}
 
-	res := make([]*PartitionAtKeys, 0, len(p))
-	for _, v := range p {
-		vv := v.(map[string]interface{})
-		keys := vv["keys"].([]interface{})
-		pp := &PartitionAtKeys{}
-		for i, k := range keys {
-			if i == len(primaryKeyColumns) {
-				return nil, fmt.Errorf("can not be more partition keys than primary key columns")
-			}
-			got, err := parsePartitionKey(k.(string), primaryKeyColumns[i].Type)
-			if err != nil {
-				return nil, err
-			}
-			pp.Keys = append(pp.Keys, got)
-		}
-		res = append(res, pp)
+func expandTableReplicasSettings(d *schema.ResourceData) (*ReplicationSettings, error) {
+	replicas, err := expandReadReplicas(d)
+	if err != nil || replicas == nil {
+		return nil, err
 	}
-	return res, nil
+	return &ReplicationSettings{ReadReplicas: replicas}, nil
 }
 
 func expandTablePartitioningPolicySettings(d *schema.ResourceData, columns []*Column, primaryKeyColumns []string) (p *PartitioningSettings, err error) {
@@ -200,18 +263,6 @@ func expandTablePartitioningPolicySettings(d *schema.ResourceData, columns []*Co
 
 	p = &PartitioningSettings{}
 
-	pk := make(map[string]struct{})
-	for _, v := range primaryKeyColumns {
-		pk[v] = struct{}{}
-	}
-
-	primaryKeyCols := make([]*Column, 0, len(primaryKeyColumns))
-	for _, v := range columns {
-		if _, ok := pk[v.Name]; ok {
-			primaryKeyCols = append(primaryKeyCols, v)
-		}
-	}
-
 	pList := v.([]interface{})
 	for _, l := range pList {
 		m := l.(map[string]interface{})
@@ -219,7 +270,7 @@ func expandTablePartitioningPolicySettings(d *schema.ResourceData, columns []*Co
 			p.PartitionsCount = partitionsCount
 		}
 		if explicitPartitions, ok := m["partition_at_keys"].([]interface{}); ok {
-			p.PartitionAtKeys, err = expandPartitionAtKeys(explicitPartitions, primaryKeyCols)
+			p.PartitionAtKeys, err = expandPartitionAtKeys(explicitPartitions, columns, primaryKeyColumns)
 			if err != nil {
 				return nil, err
 			}
@@ -290,7 +341,10 @@ func tableResourceSchemaToTableResource(d *schema.ResourceData) (*Resource, erro
 		return nil, fmt.Errorf("failed to expand table partitioning settings: %w", err)
 	}
 
-	replicasSettings := expandTableReplicasSettings(d)
+	replicasSettings, err := expandTableReplicasSettings(d)
+	if err != nil {
+		return nil, fmt.Errorf("failed to expand read replicas settings: %w", err)
+	}
 
 	var bloomFilterEnabled *bool
 	if v, ok := d.GetOk("key_bloom_filter"); ok {
@@ -328,7 +382,8 @@ func tableResourceSchemaToTableResource(d *schema.ResourceData) (*Resource, erro
 	}, nil
 }
 
-func flattenTablePartitioningSettings(d *schema.ResourceData, settings options.PartitioningSettings) []interface{} {
+func flattenTablePartitioningSettings(d *schema.ResourceData, desc options.Description) []interface{} {
+	settings := desc.PartitioningSettings
 	output := make([]interface{}, 0, 1)
 	partitioningSettings := make(map[string]interface{})
 	partitioningSettings["auto_partitioning_by_load"] = settings.PartitioningByLoad == options.FeatureEnabled
@@ -340,7 +395,10 @@ func flattenTablePartitioningSettings(d *schema.ResourceData, settings options.P
 	pList := d.Get("partitioning_settings").([]interface{})
 	for _, l := range pList {
 		m := l.(map[string]interface{})
-		partitioningSettings["partition_at_keys"] = m["partition_at_keys"]
+		configured, _ := m["partition_at_keys"].([]interface{})
+		partitioningSettings["partition_at_keys"] = readPartitionAtKeys(
+			configured, expandColumns(d.Get("column")), desc.PrimaryKey, settings, desc.KeyRanges,
+		)
 		partitioningSettings["uniform_partitions"] = m["uniform_partitions"]
 	}
 
@@ -378,12 +436,40 @@ func flattenTableDescription(d *schema.ResourceData, desc options.Description, e
 		return
 	}
 
+	// Serial columns are described with their integer type and a sequence default, and defaults
+	// with their canonical literals: keep the configured spelling of both.
+	configured := make(map[string]*Column)
+	if v, ok := d.Get("column").(*schema.Set); ok {
+		for _, c := range expandColumns(v) {
+			configured[c.Name] = c
+		}
+	}
+
 	cols := make([]interface{}, 0, len(desc.Columns))
 	for _, col := range desc.Columns {
 		mp := make(map[string]interface{})
 		mp["name"] = col.Name
-		mp["type"], mp["not_null"] = unwrapType(col.Type)
+		typ, notNull := unwrapType(col.Type)
+		if hasSequenceDefault(col) {
+			if serial, ok := describedSerialTypes[typ]; ok {
+				typ = serial
+			}
+		}
+		literal := defaultLiteral(col)
+		mp["default"] = flattenDefault(literal)
 		mp["family"] = col.Family
+		if c, ok := configured[col.Name]; ok {
+			if sameColumnType(c.Type, typ) && isSerialColumn(c.Type) == isSerialColumn(typ) {
+				typ = c.Type
+			}
+			if sameDefault(c, literal) {
+				mp["default"] = c.Default
+			}
+			if sameFamily(c.Family, col.Family) {
+				mp["family"] = c.Family
+			}
+		}
+		mp["type"], mp["not_null"] = typ, notNull
 		cols = append(cols, mp)
 	}
 	err = d.Set("column", cols)
@@ -391,6 +477,11 @@ func flattenTableDescription(d *schema.ResourceData, desc options.Description, e
 		return
 	}
 
+	err = d.Set("family", flattenColumnFamilies(expandColumnFamilies(d), desc.ColumnFamilies))
+	if err != nil {
+		return
+	}
+
 	pk := make([]interface{}, 0, len(desc.PrimaryKey))
 	for _, p := range desc.PrimaryKey {
 		pk = append(pk, p)
@@ -427,11 +518,16 @@ func flattenTableDescription(d *schema.ResourceData, desc options.Description, e
 			}
 		}
 
-		err = d.Set("ttl", []any{map[string]any{
+		ttl := map[string]any{
 			"column_name":     desc.TimeToLiveSettings.ColumnName,
 			"expire_interval": interval,
 			"unit":            helpers.YDBUnitToUnit(desc.TimeToLiveSettings.ColumnUnit.ToYDB().String()),
-		}})
+		}
+		if configured := expandTableTTLSettings(d); configured != nil && len(configured.Tiers) > 0 {
+			ttl["expire_interval"] = ""
+			ttl["tier"] = flattenTTLTiers(configured.Tiers, interval)
+		}
+		err = d.Set("ttl", []any{ttl})
 		if err != nil {
 			return
 		}
@@ -445,7 +541,7 @@ func flattenTableDescription(d *schema.ResourceData, desc options.Description, e
 	if err != nil {
 		return
 	}
-	err = d.Set("partitioning_settings", flattenTablePartitioningSettings(d, desc.PartitioningSettings))
+	err = d.Set("partitioning_settings", flattenTablePartitioningSettings(d, desc))
 	if err != nil {
 		return
 	}
@@ -454,10 +550,18 @@ func flattenTableDescription(d *schema.ResourceData, desc options.Description, e
 	if err != nil {
 		return
 	}
-	if desc.ReadReplicaSettings.Type == options.ReadReplicasAnyAzReadReplicas {
-		err = d.Set("read_replicas_settings", fmt.Sprintf("ANY_AZ:%d", desc.ReadReplicaSettings.Count))
-	} else {
-		err = d.Set("read_replicas_settings", fmt.Sprintf("PER_AZ:%d", desc.ReadReplicaSettings.Count))
+	replicas := flattenReadReplicas(desc.ReadReplicaSettings)
+	err = d.Set("read_replicas", flattenReadReplicasBlock(replicas))
+	if err != nil {
+		return
+	}
+	settings := ""
+	if replicas != nil {
+		settings = replicas.String()
+		// The configured spelling of the deprecated string is kept.
+		if current := d.Get("read_replicas_settings").(string); sameReadReplicasSettings(current, settings) {
+			settings = current
+		}
 	}
-	return err
+	return d.Set("read_replicas_settings", settings)
 }
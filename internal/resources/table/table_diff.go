@@ -3,6 +3,7 @@ package table
 import (
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -12,24 +13,43 @@ import (
 type tableDiff struct {
 	TableName                 string
 	ColumnsToAdd              []*Column
+	ColumnsToDrop             []string
+	ColumnFamilyChanges       []*Column
+	FamiliesToAdd             []*Family
+	FamiliesToAlter           []*familyChange
 	NewTTLSettings            *TTL
 	NewPartitioningSettings   *PartitioningSettings
 	NewKeyBloomFilterSettings *bool
-	ReadReplicasSettings      string
+	ReadReplicas              *ReadReplicas
 	OnlyResetTTL              bool
 }
 
 func validateExistingColumnChange(name string, oldCol, newCol *Column) error {
-	if oldCol.Type != newCol.Type {
+	// Serial columns are stored as integers, but their sequence is created with the table only.
+	if isSerialColumn(oldCol.Type) != isSerialColumn(newCol.Type) {
+		return fmt.Errorf(
+			"changing column %q type from %q to %q is not supported: YDB creates the sequence of a serial column together with the table",
+			name, oldCol.Type, newCol.Type,
+		)
+	}
+	if !sameColumnType(oldCol.Type, newCol.Type) {
 		return fmt.Errorf(
 			"changing column %q type from %q to %q is not supported: YDB does not allow in-place column type changes",
 			name, oldCol.Type, newCol.Type,
 		)
 	}
+	if oldCol.Default != newCol.Default {
+		return fmt.Errorf(
+			"changing column %q default from %q to %q is not supported: YDB does not allow altering column defaults",
+			name, oldCol.Default, newCol.Default,
+		)
+	}
 	return nil
 }
 
-func checkColumnDiff(rcolumns []*Column, dcolumns []*Column) ([]*Column, error) {
+// checkColumnDiff returns the columns to add and the names of the columns to drop. Removing
+// columns is an error unless allowDrop is set.
+func checkColumnDiff(rcolumns []*Column, dcolumns []*Column, allowDrop bool) ([]*Column, []string, error) {
 	existingColumns := make(map[string]*Column)
 	for _, v := range dcolumns {
 		existingColumns[v.Name] = v
@@ -51,7 +71,7 @@ func checkColumnDiff(rcolumns []*Column, dcolumns []*Column) ([]*Column, error)
 	for name, newCol := range resourceColumns {
 		if oldCol, ok := existingColumns[name]; ok {
 			if err := validateExistingColumnChange(name, oldCol, newCol); err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 		}
 	}
@@ -62,10 +82,11 @@ func checkColumnDiff(rcolumns []*Column, dcolumns []*Column) ([]*Column, error)
 		}
 	}
 
-	if len(deletedColumns) > 0 {
-		return nil, fmt.Errorf("it is prohibited to delete columns with terraform. Columns for deletion: [%s]", strings.Join(deletedColumns, ","))
+	sort.Strings(deletedColumns)
+	if len(deletedColumns) > 0 && !allowDrop {
+		return nil, nil, fmt.Errorf("it is prohibited to delete columns with terraform. Columns for deletion: [%s]. Set allow_column_drop = true to drop them", strings.Join(deletedColumns, ","))
 	}
-	return columnsToAdd, nil
+	return columnsToAdd, deletedColumns, nil
 }
 
 func compareIndexes(ridx *Index, didx options.IndexDescription) bool {
@@ -131,11 +152,21 @@ func prepareTableDiff(d *schema.ResourceData) (*tableDiff, error) {
 		o, n := d.GetChange("column")
 		oColumns := expandColumns(o)
 		nColumns := expandColumns(n)
-		newColumns, err := checkColumnDiff(nColumns, oColumns)
+		newColumns, droppedColumns, err := checkColumnDiff(nColumns, oColumns, d.Get("allow_column_drop").(bool))
 		if err != nil {
 			return nil, err
 		}
 		diff.ColumnsToAdd = newColumns
+		diff.ColumnsToDrop = droppedColumns
+		diff.ColumnFamilyChanges = checkColumnFamilyChanges(nColumns, oColumns)
+	}
+	if d.HasChange("family") {
+		o, n := d.GetChange("family")
+		var err error
+		diff.FamiliesToAdd, diff.FamiliesToAlter, err = checkFamilyDiff(expandFamilies(n), expandFamilies(o))
+		if err != nil {
+			return nil, err
+		}
 	}
 	if d.HasChange("ttl") {
 		diff.NewTTLSettings = expandTableTTLSettings(d)
@@ -158,9 +189,11 @@ func prepareTableDiff(d *schema.ResourceData) (*tableDiff, error) {
 		}
 		diff.NewKeyBloomFilterSettings = &val
 	}
-	if d.HasChange("read_replicas_settings") {
-		if v, ok := d.GetOk("read_replicas_settings"); ok {
-			diff.ReadReplicasSettings = v.(string)
+	if d.HasChange("read_replicas") || readReplicasSettingsChanged(d) {
+		var err error
+		diff.ReadReplicas, err = expandReadReplicas(d)
+		if err != nil {
+			return nil, fmt.Errorf("failed to expand read replicas settings: %w", err)
 		}
 	}
 
@@ -6,15 +6,18 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
 )
 
 func TestCheckColumnDiff(t *testing.T) {
 	testData := []struct {
-		testName             string
-		rcolumns             []*Column
-		dcolumns             []*Column
-		expectedColumnsToAdd []*Column
-		expectedError        bool
+		testName              string
+		rcolumns              []*Column
+		dcolumns              []*Column
+		allowDrop             bool
+		expectedColumnsToAdd  []*Column
+		expectedColumnsToDrop []string
+		expectedError         bool
 	}{
 		{
 			testName: "empty resource columns and empty table columns",
@@ -42,6 +45,45 @@ func TestCheckColumnDiff(t *testing.T) {
 			},
 			expectedError: true,
 		},
+		{
+			testName: "making a column serial",
+			rcolumns: []*Column{
+				{Name: "a", Type: "Serial"},
+			},
+			dcolumns: []*Column{
+				{Name: "a", Type: "Int32"},
+			},
+			expectedError: true,
+		},
+		{
+			testName: "making a serial column non-serial",
+			rcolumns: []*Column{
+				{Name: "a", Type: "Int64"},
+			},
+			dcolumns: []*Column{
+				{Name: "a", Type: "BigSerial"},
+			},
+			expectedError: true,
+		},
+		{
+			testName: "changing serial column spelling",
+			rcolumns: []*Column{
+				{Name: "a", Type: "Serial4"},
+			},
+			dcolumns: []*Column{
+				{Name: "a", Type: "Serial"},
+			},
+		},
+		{
+			testName: "changing column default",
+			rcolumns: []*Column{
+				{Name: "a", Type: "Utf8", Default: "new"},
+			},
+			dcolumns: []*Column{
+				{Name: "a", Type: "Utf8", Default: "old"},
+			},
+			expectedError: true,
+		},
 		{
 			testName: "resource with deleting columns",
 			rcolumns: []*Column{
@@ -65,12 +107,29 @@ func TestCheckColumnDiff(t *testing.T) {
 			},
 			expectedError: true,
 		},
+		{
+			testName: "resource with deleting columns when drops are allowed",
+			rcolumns: []*Column{
+				{Name: "a"},
+				{Name: "d"},
+			},
+			dcolumns: []*Column{
+				{Name: "a"},
+				{Name: "c"},
+				{Name: "b"},
+			},
+			allowDrop: true,
+			expectedColumnsToAdd: []*Column{
+				{Name: "d"},
+			},
+			expectedColumnsToDrop: []string{"b", "c"},
+		},
 	}
 
 	for _, v := range testData {
 		v := v
 		t.Run(v.testName, func(t *testing.T) {
-			got, err := checkColumnDiff(v.rcolumns, v.dcolumns)
+			got, gotDrop, err := checkColumnDiff(v.rcolumns, v.dcolumns, v.allowDrop)
 			if v.expectedError {
 				assert.Error(t, err)
 				if v.testName == "changing column type" {
@@ -80,6 +139,7 @@ func TestCheckColumnDiff(t *testing.T) {
 				assert.NoError(t, err)
 			}
 			assert.Equal(t, v.expectedColumnsToAdd, got)
+			assert.Equal(t, v.expectedColumnsToDrop, gotDrop)
 		})
 	}
 }
@@ -340,3 +400,29 @@ func TestCheckIndexDiff(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckTablestoreSchema(t *testing.T) {
+	desc := options.Description{
+		Columns: []options.Column{
+			{Name: "id", Type: types.TypeUint64},
+			{Name: "value", Type: types.Optional(types.TypeUTF8)},
+		},
+		PrimaryKey: []string{"id"},
+	}
+
+	err := checkTablestoreSchema("olap", []*Column{
+		{Name: "value", Type: "utf8"},
+		{Name: "id", Type: "Uint64", NotNull: true},
+	}, []string{"id"}, desc)
+	assert.NoError(t, err)
+
+	err = checkTablestoreSchema("olap", []*Column{
+		{Name: "id", Type: "Uint64", NotNull: true},
+		{Name: "extra", Type: "Utf8"},
+	}, []string{"id", "extra"}, desc)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), `column "extra" is not defined in the tablestore`)
+		assert.Contains(t, err.Error(), `tablestore column "value" is missing`)
+		assert.Contains(t, err.Error(), "primary key must be [id]")
+	}
+}
@@ -0,0 +1,160 @@
+package table
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ydb "github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
+)
+
+// ValidateResourceDiffTablestore checks at plan time that a table placed in a tablestore is a
+// column table located inside the tablestore.
+func ValidateResourceDiffTablestore(d *schema.ResourceDiff) error {
+	store := d.Get("tablestore").(string)
+	if store == "" || !d.NewValueKnown("tablestore") {
+		return nil
+	}
+	if d.Get("store").(string) != "column" {
+		return fmt.Errorf("tables in tablestore %q are column tables: set store = \"column\"", store)
+	}
+	if !d.NewValueKnown("path") {
+		return nil
+	}
+	tablePath := helpers.TrimPath(d.Get("path").(string))
+	if !strings.HasPrefix(tablePath, helpers.TrimPath(store)+"/") {
+		return fmt.Errorf("table %q must be located inside tablestore %q, e.g. %q", tablePath, store, helpers.TrimPath(store)+"/"+tablePath)
+	}
+	return nil
+}
+
+// ValidateTablestoreSchema checks at plan time that a table placed in a tablestore inherits its
+// schema: the columns and primary key must be the ones of the tablestore. The check is skipped
+// while the tablestore does not exist yet, e.g. when it is created by the same apply.
+func ValidateTablestoreSchema(ctx context.Context, d *schema.ResourceDiff, authCreds auth.YdbCredentials) error {
+	store := d.Get("tablestore").(string)
+	connectionString := d.Get("connection_string").(string)
+	if store == "" || connectionString == "" {
+		return nil
+	}
+	for _, k := range []string{"tablestore", "connection_string", "column", "primary_key"} {
+		if !d.NewValueKnown(k) {
+			return nil
+		}
+	}
+	if d.Id() != "" && !d.HasChanges("column", "primary_key") {
+		return nil
+	}
+
+	_, database, _, err := helpers.ParseYDBDatabaseEndpoint(connectionString)
+	if err != nil {
+		return err
+	}
+	storePath := helpers.JoinYDBCatalogPath(database, store)
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: connectionString,
+		AuthCreds:        authCreds,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to initialize table client: %w", err)
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	entry, err := db.Scheme().DescribePath(ctx, storePath)
+	if err != nil {
+		if ydb.IsOperationErrorSchemeError(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to describe tablestore %q: %w", storePath, err)
+	}
+	if entry.Type != scheme.EntryColumnStore {
+		return fmt.Errorf("path %q is a %s, not a tablestore", storePath, entry.Type)
+	}
+
+	var desc options.Description
+	err = db.Table().Do(ctx, func(ctx context.Context, s table.Session) (err error) {
+		desc, err = s.DescribeTable(ctx, storePath)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to describe tablestore %q: %w", storePath, err)
+	}
+
+	pkRaw := d.Get("primary_key").([]interface{})
+	pk := make([]string, 0, len(pkRaw))
+	for _, v := range pkRaw {
+		pk = append(pk, v.(string))
+	}
+	return checkTablestoreSchema(store, expandColumns(d.Get("column")), pk, desc)
+}
+
+// parentTablestore returns the path of the tablestore a column table is placed in, relative to the
+// database root, or "" for a standalone column table.
+func parentTablestore(ctx context.Context, db *tbl.Driver, entity *helpers.YDBEntity) (string, error) {
+	parent := path.Dir(entity.GetFullEntityPath())
+	if parent == entity.GetDatabasePath() {
+		return "", nil
+	}
+	entry, err := db.Scheme().DescribePath(ctx, parent)
+	if err != nil {
+		return "", fmt.Errorf("failed to describe path %q: %w", parent, err)
+	}
+	if entry.Type != scheme.EntryColumnStore {
+		return "", nil
+	}
+	return helpers.RelativizeYDBCatalogPath(entity.GetDatabasePath(), parent), nil
+}
+
+func checkTablestoreSchema(store string, columns []*Column, primaryKey []string, desc options.Description) error {
+	storeColumns := make(map[string]*Column, len(desc.Columns))
+	for _, c := range desc.Columns {
+		typ, notNull := unwrapType(c.Type)
+		storeColumns[c.Name] = &Column{Name: c.Name, Type: typ, NotNull: notNull}
+	}
+
+	var problems []string
+	seen := make(map[string]struct{}, len(columns))
+	for _, c := range columns {
+		seen[c.Name] = struct{}{}
+		sc, ok := storeColumns[c.Name]
+		switch {
+		case !ok:
+			problems = append(problems, fmt.Sprintf("column %q is not defined in the tablestore", c.Name))
+		case !strings.EqualFold(sc.Type, c.Type) || sc.NotNull != c.NotNull:
+			// Tablestore columns have no defaults, so their definition always renders.
+			definition, _ := sc.ToYQL()
+			problems = append(problems, fmt.Sprintf("column %q is %s in the tablestore", c.Name, definition))
+		}
+	}
+	var missing []string
+	for name := range storeColumns {
+		if _, ok := seen[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	sort.Strings(missing)
+	for _, name := range missing {
+		problems = append(problems, fmt.Sprintf("tablestore column %q is missing", name))
+	}
+	if strings.Join(primaryKey, ",") != strings.Join(desc.PrimaryKey, ",") {
+		problems = append(problems, fmt.Sprintf("primary key must be [%s]", strings.Join(desc.PrimaryKey, ", ")))
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("table schema does not match tablestore %q: %s", store, strings.Join(problems, "; "))
+	}
+	return nil
+}
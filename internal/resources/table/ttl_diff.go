@@ -0,0 +1,108 @@
+package table
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	ttlDateColumnTypes  = []string{"Date", "Datetime", "Timestamp", "Date32", "Datetime64", "Timestamp64"}
+	ttlEpochColumnTypes = []string{"Uint32", "Uint64", "DyNumber"}
+)
+
+// ValidateResourceDiffTTL checks the TTL settings at plan time: the TTL column type, and the
+// order and kinds of the TTL tiers.
+func ValidateResourceDiffTTL(d *schema.ResourceDiff) error {
+	v, ok := d.GetOk("ttl")
+	if !ok {
+		return nil
+	}
+	var columns []*Column
+	if c, ok := d.Get("column").(*schema.Set); ok {
+		columns = expandColumns(c)
+	}
+	columnStore := strings.EqualFold(d.Get("store").(string), "column")
+	for _, raw := range v.(*schema.Set).List() {
+		m := raw.(map[string]interface{})
+		ttl := &TTL{
+			ColumnName:     m["column_name"].(string),
+			ExpireInterval: m["expire_interval"].(string),
+			Unit:           m["unit"].(string),
+			Tiers:          expandTTLTiers(m["tier"]),
+		}
+		if err := validateTTL(ttl, columns, columnStore); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateTTL(ttl *TTL, columns []*Column, columnStore bool) error {
+	if (ttl.ExpireInterval == "") == (len(ttl.Tiers) == 0) {
+		return fmt.Errorf("ttl: exactly one of expire_interval and tier must be set")
+	}
+	if err := validateTTLColumn(ttl, columns); err != nil {
+		return err
+	}
+	if ttl.ExpireInterval != "" {
+		_, err := iso8601ToTTL(ttl.ExpireInterval)
+		return err
+	}
+
+	var prev string
+	for i, tier := range ttl.Tiers {
+		if tier.isDelete() && i != len(ttl.Tiers)-1 {
+			return fmt.Errorf("ttl: only the last tier can delete rows, tier %d has no external_data_source", i+1)
+		}
+		if !tier.isDelete() && !columnStore {
+			return fmt.Errorf("ttl: moving rows to an external data source is supported for column tables only, set store = \"column\"")
+		}
+		if tier.ExpireInterval == "" {
+			continue
+		}
+		cur, err := iso8601ToTTL(tier.ExpireInterval)
+		if err != nil {
+			return err
+		}
+		if prev != "" {
+			if p, _ := iso8601ToTTL(prev); cur <= p {
+				return fmt.Errorf("ttl: tiers must be ordered by increasing expire_interval, %q follows %q", tier.ExpireInterval, prev)
+			}
+		}
+		prev = tier.ExpireInterval
+	}
+	return nil
+}
+
+func validateTTLColumn(ttl *TTL, columns []*Column) error {
+	if ttl.ColumnName == "" {
+		return nil
+	}
+	for _, c := range columns {
+		if c.Name != ttl.ColumnName {
+			continue
+		}
+		typ := storageType(c.Type)
+		if ttl.Unit == "" && !containsFold(ttlDateColumnTypes, typ) {
+			return fmt.Errorf("ttl: column %q of type %s requires unit, or must be one of %s",
+				c.Name, c.Type, strings.Join(ttlDateColumnTypes, ", "))
+		}
+		if ttl.Unit != "" && !containsFold(ttlEpochColumnTypes, typ) {
+			return fmt.Errorf("ttl: unit is only supported for columns of type %s, column %q is %s",
+				strings.Join(ttlEpochColumnTypes, ", "), c.Name, c.Type)
+		}
+		return nil
+	}
+	return fmt.Errorf("ttl: column %q is not declared in the table", ttl.ColumnName)
+}
+
+func containsFold(list []string, s string) bool {
+	for _, v := range list {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,134 @@
+package table
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTTL(t *testing.T) {
+	columns := []*Column{
+		{Name: "ts", Type: "Timestamp"},
+		{Name: "epoch", Type: "Uint64"},
+		{Name: "name", Type: "Utf8"},
+	}
+	coldThenDelete := []TTLTier{
+		{ExpireInterval: "P1D", ExternalDataSource: "cold"},
+		{ExpireInterval: "P30D"},
+	}
+
+	testData := []struct {
+		testName      string
+		ttl           *TTL
+		columnStore   bool
+		expectedError string
+	}{
+		{
+			testName: "single delete rule",
+			ttl:      &TTL{ColumnName: "ts", ExpireInterval: "P1D"},
+		},
+		{
+			testName:    "eviction tiers on a column table",
+			ttl:         &TTL{ColumnName: "epoch", Unit: "seconds", Tiers: coldThenDelete},
+			columnStore: true,
+		},
+		{
+			testName:      "both expire_interval and tiers",
+			ttl:           &TTL{ColumnName: "ts", ExpireInterval: "P1D", Tiers: coldThenDelete},
+			columnStore:   true,
+			expectedError: "exactly one of expire_interval and tier",
+		},
+		{
+			testName:      "neither expire_interval nor tiers",
+			ttl:           &TTL{ColumnName: "ts"},
+			expectedError: "exactly one of expire_interval and tier",
+		},
+		{
+			testName: "unordered tiers",
+			ttl: &TTL{ColumnName: "ts", Tiers: []TTLTier{
+				{ExpireInterval: "P2D", ExternalDataSource: "warm"},
+				{ExpireInterval: "PT24H", ExternalDataSource: "cold"},
+			}},
+			columnStore:   true,
+			expectedError: `"PT24H" follows "P2D"`,
+		},
+		{
+			testName: "delete tier before eviction",
+			ttl: &TTL{ColumnName: "ts", Tiers: []TTLTier{
+				{ExpireInterval: "P1D"},
+				{ExpireInterval: "P2D", ExternalDataSource: "cold"},
+			}},
+			columnStore:   true,
+			expectedError: "only the last tier can delete rows",
+		},
+		{
+			testName:      "eviction on a row table",
+			ttl:           &TTL{ColumnName: "ts", Tiers: coldThenDelete},
+			expectedError: "column tables only",
+		},
+		{
+			testName:      "string column",
+			ttl:           &TTL{ColumnName: "name", ExpireInterval: "P1D"},
+			expectedError: `column "name" of type Utf8 requires unit`,
+		},
+		{
+			testName:      "unit on a date column",
+			ttl:           &TTL{ColumnName: "ts", ExpireInterval: "P1D", Unit: "seconds"},
+			expectedError: "unit is only supported",
+		},
+		{
+			testName:      "unknown column",
+			ttl:           &TTL{ColumnName: "missing", ExpireInterval: "P1D"},
+			expectedError: `column "missing" is not declared`,
+		},
+		{
+			testName:      "months interval",
+			ttl:           &TTL{ColumnName: "ts", ExpireInterval: "P1M"},
+			expectedError: "years and months are not supported",
+		},
+	}
+
+	for _, v := range testData {
+		v := v
+		t.Run(v.testName, func(t *testing.T) {
+			err := validateTTL(v.ttl, columns, v.columnStore)
+			if v.expectedError == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), v.expectedError)
+		})
+	}
+}
+
+func TestIso8601ToTTL(t *testing.T) {
+	got, err := iso8601ToTTL("P1W2DT3H4M5S")
+	require.NoError(t, err)
+	assert.Equal(t, 9*24*time.Hour+3*time.Hour+4*time.Minute+5*time.Second, got)
+	assert.Equal(t, "P1W2DT3H4M5S", ttlToISO8601(got))
+
+	_, err = iso8601ToTTL("1 day")
+	assert.Error(t, err)
+}
+
+func TestFlattenTTLTiers(t *testing.T) {
+	tiers := []TTLTier{
+		{ExpireInterval: "P1D", ExternalDataSource: "cold"},
+		{ExpireInterval: "P30D"},
+	}
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"expire_interval": "P1D", "external_data_source": "cold"},
+		map[string]interface{}{"expire_interval": "P30D", "external_data_source": ""},
+	}, flattenTTLTiers(tiers, ""))
+
+	deleteOnly := []TTLTier{{ExpireInterval: "PT24H"}}
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"expire_interval": "PT24H", "external_data_source": ""},
+	}, flattenTTLTiers(deleteOnly, "P1D"))
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"expire_interval": "P2D", "external_data_source": ""},
+	}, flattenTTLTiers(deleteOnly, "P2D"))
+}
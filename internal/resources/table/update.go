@@ -2,23 +2,35 @@ package table
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/ydb-platform/ydb-go-sdk/v3/table"
 
 	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
 )
 
-func prepareAlterRequest(tableName string, d *schema.ResourceData) (string, error) {
+func prepareAlterRequest(ctx context.Context, db *tbl.Driver, r *Resource, d *schema.ResourceData) (string, error) {
 	diff, err := prepareTableDiff(d)
 	if err != nil {
 		return "", err
 	}
-	diff.TableName = tableName
+	diff.TableName = r.Path
 
-	query := PrepareAlterRequest(diff)
-	return query, nil
+	// Indexes are managed by separate resources, so the columns they use are checked again
+	// right before they are dropped.
+	if len(diff.ColumnsToDrop) > 0 {
+		description, err := db.DescribeTable(ctx, r.Entity.GetFullEntityPath())
+		if err != nil {
+			return "", fmt.Errorf("failed to describe table %q: %w", r.Path, err)
+		}
+		if err := checkIndexedColumnDrops(diff.ColumnsToDrop, description.Indexes); err != nil {
+			return "", err
+		}
+	}
+
+	return PrepareAlterRequest(diff)
 }
 
 func (h *handler) Update(ctx context.Context, d *schema.ResourceData, cfg interface{}) diag.Diagnostics {
@@ -44,20 +56,51 @@ func (h *handler) Update(ctx context.Context, d *schema.ResourceData, cfg interf
 		_ = db.Close(ctx)
 	}()
 
-	request, err := prepareAlterRequest(tableResource.Path, d)
-	if err != nil {
-		return diag.FromErr(err)
+	if d.HasChange("path") {
+		if err = renameIfRequired(ctx, db, d, tableResource); err != nil {
+			return diag.FromErr(err)
+		}
+		// The entity of the table follows the new ID.
+		tableResource, err = tableResourceSchemaToTableResource(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
-	// NOTE(shmel1k@): no query after all checks.
-	if request == "" {
-		return nil
+	backups := expandMigrationBackups(d.Get("migration_backups"))
+	if migrationRequired(d) {
+		retention, err := expandMigrationRetention(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		backupPath, err := migrateTable(ctx, db, tableResource)
+		if err != nil {
+			return diag.Errorf("failed to migrate table %q: %s", tableResource.Path, err)
+		}
+		backup := &MigrationBackup{Path: backupPath}
+		if retention > 0 {
+			backup.ExpiresAt = time.Now().Add(retention)
+		}
+		backups = append(backups, backup)
+	} else {
+		request, err := prepareAlterRequest(ctx, db, tableResource, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// NOTE(shmel1k@): no query after all checks.
+		if request != "" {
+			err = db.ExecuteSchemeQuery(ctx, request, tbl.NonIdempotent)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
 	}
 
-	err = db.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
-		err = s.ExecuteSchemeQuery(ctx, request)
-		return err
-	})
+	backups, err = dropBackups(ctx, db, backups, expiredBackups(backups, time.Now()))
+	if setErr := d.Set("migration_backups", flattenMigrationBackups(backups)); setErr != nil {
+		return diag.FromErr(setErr)
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -3,52 +3,65 @@ package table
 import (
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/senseyeio/duration"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
 )
 
-func isIntColumn(typ string) bool {
-	return typ == "Int8" || typ == "Int16" || typ == "Int32" || typ == "Int64"
+// serialTypes maps serial column types to the integer types YDB stores and reports them as.
+// Values of serial columns are taken from a sequence created together with the table.
+var serialTypes = map[string]string{
+	"smallserial": "Int16",
+	"serial2":     "Int16",
+	"serial":      "Int32",
+	"serial4":     "Int32",
+	"bigserial":   "Int64",
+	"serial8":     "Int64",
 }
 
-func isUintColumn(typ string) bool {
-	return typ == "Uint8" || typ == "Uint16" || typ == "Uint32" || typ == "Uint64"
+// describedSerialTypes maps the integer types YDB reports serial columns as to a serial spelling.
+var describedSerialTypes = map[string]string{
+	"Int16": "SmallSerial",
+	"Int32": "Serial",
+	"Int64": "BigSerial",
 }
 
-func isBoolColumn(typ string) bool {
-	return typ == "Bool"
+// hasSequenceDefault reports whether a described column takes its values from a sequence, which
+// is how YDB describes serial columns.
+func hasSequenceDefault(col options.Column) bool {
+	return col.DefaultValue != nil && col.DefaultValue.Sequence() != nil
 }
 
-func isFloatColumn(typ string) bool {
-	return typ == "Float" || typ == "Decimal" || typ == "Double"
+// serialColumns returns the names of the described serial columns.
+func serialColumns(columns []options.Column) []string {
+	var res []string
+	for _, col := range columns {
+		if hasSequenceDefault(col) {
+			res = append(res, col.Name)
+		}
+	}
+	return res
 }
 
-func isUTF8Column(typ string) bool {
-	return typ == "Utf8"
+func isSerialColumn(typ string) bool {
+	_, ok := serialTypes[strings.ToLower(typ)]
+	return ok
 }
 
-func isStringColumn(typ string) bool {
-	return typ == "String" || typ == "Bytes" || typ == "Optional<String>"
+// storageType returns the type a column of type typ is described with.
+func storageType(typ string) string {
+	if t, ok := serialTypes[strings.ToLower(typ)]; ok {
+		return t
+	}
+	return typ
 }
 
-func parsePartitionKey(k string, typ string) (interface{}, error) {
-	if isIntColumn(typ) {
-		return strconv.ParseInt(k, 10, 64)
-	}
-	if isUintColumn(typ) {
-		return strconv.ParseUint(k, 10, 64)
-	}
-	if isFloatColumn(typ) {
-		return strconv.ParseFloat(k, 64)
-	}
-	if isStringColumn(typ) || isUTF8Column(typ) {
-		return k, nil
-	}
-	if isBoolColumn(typ) {
-		return strconv.ParseBool(k)
-	}
-	return nil, fmt.Errorf("unknown column type %q", typ)
+// sameColumnType reports whether a and b describe the same column type, e.g. Serial and Int32.
+func sameColumnType(a, b string) bool {
+	return storageType(a) == storageType(b)
 }
 
 func expandColumns(cols interface{}) []*Column {
@@ -68,6 +81,9 @@ func expandColumns(cols interface{}) []*Column {
 		if notNull, ok := mp["not_null"]; ok {
 			col.NotNull = notNull.(bool)
 		}
+		if def, ok := mp["default"].(string); ok {
+			col.Default = def
+		}
 		columns = append(columns, col)
 	}
 
@@ -84,26 +100,7 @@ func expandPrimaryKey(d *schema.ResourceData) []string {
 }
 
 func expandColumnFamilies(d *schema.ResourceData) []*Family {
-	familiesRaw := d.Get("family")
-	if familiesRaw == nil {
-		return nil
-	}
-
-	raw := familiesRaw.([]interface{})
-	families := make([]*Family, 0, len(raw))
-	for _, rw := range raw {
-		r := rw.(map[string]interface{})
-		name := r["name"].(string)
-		data := r["data"].(string)
-		compression := r["compression"].(string)
-		families = append(families, &Family{
-			Name:        name,
-			Data:        data,
-			Compression: compression,
-		})
-	}
-
-	return families
+	return expandFamilies(d.Get("family"))
 }
 
 func expandAttributes(d *schema.ResourceData) map[string]string {
@@ -181,3 +178,18 @@ func ttlToISO8601(ttl time.Duration) string {
 	}
 	return string(result)
 }
+
+// iso8601ToTTL is the inverse of ttlToISO8601. Years and months have no fixed length,
+// so YQL intervals do not support them.
+func iso8601ToTTL(interval string) (time.Duration, error) {
+	d, err := duration.ParseISO8601(interval)
+	if err != nil {
+		return 0, fmt.Errorf("invalid ISO 8601 interval %q: %w", interval, err)
+	}
+	if d.Y != 0 || d.M != 0 {
+		return 0, fmt.Errorf("invalid interval %q: years and months are not supported", interval)
+	}
+	const day = 24 * time.Hour
+	return time.Duration(d.W)*7*day + time.Duration(d.D)*day +
+		time.Duration(d.TH)*time.Hour + time.Duration(d.TM)*time.Minute + time.Duration(d.TS)*time.Second, nil
+}
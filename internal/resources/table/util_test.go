@@ -64,3 +64,17 @@ func TestTTLToISO8601(t *testing.T) {
 		})
 	}
 }
+
+func TestSameColumnType(t *testing.T) {
+	assert.True(t, sameColumnType("Serial", "Int32"))
+	assert.True(t, sameColumnType("bigserial", "Int64"))
+	assert.True(t, sameColumnType("Serial2", "Int16"))
+	assert.True(t, sameColumnType("Utf8", "Utf8"))
+	assert.False(t, sameColumnType("Serial", "Int64"))
+	assert.True(t, isSerialColumn("BigSerial"))
+	assert.False(t, isSerialColumn("Int64"))
+
+	key, err := parsePartitionKey("10", "BigSerial")
+	require.NoError(t, err)
+	assert.Equal(t, "10l", key.Yql())
+}
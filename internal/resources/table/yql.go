@@ -16,7 +16,7 @@ func appendIndent(req []byte, indent int) []byte {
 	return req
 }
 
-func PrepareCreateRequest(r *Resource) string { //nolint:gocyclo
+func PrepareCreateRequest(r *Resource) (string, error) { //nolint:gocyclo
 	req := make([]byte, 0, defaultRequestCapacity)
 
 	req = append(req, "CREATE TABLE `"...)
@@ -26,8 +26,12 @@ func PrepareCreateRequest(r *Resource) string { //nolint:gocyclo
 
 	indent := 1
 	for _, v := range r.Columns {
+		column, err := v.ToYQL()
+		if err != nil {
+			return "", err
+		}
 		req = appendIndent(req, indent)
-		req = append(req, v.ToYQL()...)
+		req = append(req, column...)
 		req = append(req, ',')
 		req = append(req, '\n')
 	}
@@ -69,6 +73,12 @@ func PrepareCreateRequest(r *Resource) string { //nolint:gocyclo
 			req = append(req, '"')
 			req = helpers.AppendWithEscape(req, v.Compression)
 			req = append(req, '"')
+			if v.CompressionLevel != 0 {
+				req = append(req, ',', '\n')
+				req = appendIndent(req, indent)
+				req = append(req, "COMPRESSION_LEVEL = "...)
+				req = strconv.AppendInt(req, int64(v.CompressionLevel), 10)
+			}
 			req = append(req, '\n')
 			indent--
 			req = appendIndent(req, indent)
@@ -114,7 +124,7 @@ func PrepareCreateRequest(r *Resource) string { //nolint:gocyclo
 	}
 
 	if !needWith {
-		return string(req)
+		return string(req), nil
 	}
 
 	req = append(req, "WITH"...)
@@ -133,19 +143,11 @@ func PrepareCreateRequest(r *Resource) string { //nolint:gocyclo
 	}
 
 	if r.TTL != nil {
-		req = appendIndent(req, indent)
-		req = append(req, "TTL = Interval(\""...)
-		req = helpers.AppendWithEscape(req, r.TTL.ExpireInterval)
-		req = append(req, '"')
-		req = append(req, ')')
-		req = append(req, " ON "...)
-		req = append(req, '`')
-		req = helpers.AppendWithEscape(req, r.TTL.ColumnName)
-		req = append(req, '`')
-		if r.TTL.Unit != "" {
-			req = append(req, " AS "...)
-			req = append(req, r.TTL.Unit...)
+		if needComma {
+			req = append(req, ',', '\n')
 		}
+		req = appendIndent(req, indent)
+		req = append(req, r.TTL.ToYQL()...)
 		needComma = true
 	}
 	if r.PartitioningSettings != nil { //nolint:nestif
@@ -201,18 +203,7 @@ func PrepareCreateRequest(r *Resource) string { //nolint:gocyclo
 			for i, v := range r.PartitioningSettings.PartitionAtKeys {
 				req = append(req, '(')
 				for ii, vv := range v.Keys {
-					switch t := vv.(type) {
-					case uint64:
-						req = strconv.AppendUint(req, t, 10)
-					case int64:
-						req = strconv.AppendInt(req, t, 10)
-					case bool:
-						req = strconv.AppendBool(req, t)
-					case string:
-						req = append(req, '"')
-						req = helpers.AppendWithEscape(req, t)
-						req = append(req, '"')
-					}
+					req = append(req, keyLiteral(vv)...)
 					if ii < len(v.Keys)-1 {
 						req = append(req, ',')
 					}
@@ -246,14 +237,12 @@ func PrepareCreateRequest(r *Resource) string { //nolint:gocyclo
 			needComma = true
 		}
 	}
-	if r.ReplicationSettings != nil && r.ReplicationSettings.ReadReplicasSettings != "" {
+	if r.ReplicationSettings != nil && r.ReplicationSettings.ReadReplicas != nil {
 		if needComma {
 			req = append(req, ',', '\n')
 		}
 		req = appendIndent(req, indent)
-		req = append(req, "READ_REPLICAS_SETTINGS = \""...)
-		req = helpers.AppendWithEscape(req, r.ReplicationSettings.ReadReplicasSettings)
-		req = append(req, '"')
+		req = appendReadReplicas(req, r.ReplicationSettings.ReadReplicas)
 		needComma = true
 	}
 	if r.EnableBloomFilter != nil {
@@ -280,22 +269,26 @@ func PrepareCreateRequest(r *Resource) string { //nolint:gocyclo
 	//		req = append(req, prepareCDCAlterQuery(r.Path, r.ChangeFeeds)...)
 	//	}
 
-	return string(req)
+	return string(req), nil
 }
 
-func prepareAddColumnsQuery(tableName string, columnsToAdd []*Column) string {
+func prepareAddColumnsQuery(tableName string, columnsToAdd []*Column) (string, error) {
 	req := []byte("ALTER TABLE `")
 	req = helpers.AppendWithEscape(req, tableName)
 	req = append(req, '`', ' ')
 	for i := 0; i < len(columnsToAdd); i++ {
+		column, err := columnsToAdd[i].ToYQL()
+		if err != nil {
+			return "", err
+		}
 		req = append(req, "ADD COLUMN "...)
-		req = append(req, columnsToAdd[i].ToYQL()...)
+		req = append(req, column...)
 		if i != len(columnsToAdd)-1 {
 			req = append(req, ',', ' ')
 		}
 	}
 
-	return string(req)
+	return string(req), nil
 }
 
 func prepareDropColumnsQuery(tableName string, columnsToDrop []string) string {
@@ -354,7 +347,7 @@ func prepareKeyBloomFilterQuery(tableName string, enabled bool) string {
 func prepareNewPartitioningSettingsQuery(
 	tableName string,
 	settings *PartitioningSettings,
-	readReplicaSettings string,
+	readReplicas *ReadReplicas,
 ) string {
 	buf := make([]byte, 0, 64)
 	buf = append(buf, "ALTER TABLE `"...)
@@ -405,32 +398,46 @@ func prepareNewPartitioningSettingsQuery(
 		buf = append(buf, "AUTO_PARTITIONING_MAX_PARTITIONS_COUNT = "...)
 		buf = strconv.AppendInt(buf, int64(settings.MaxPartitionsCount), 10)
 	}
-	if readReplicaSettings != "" {
+	if readReplicas != nil {
 		if needComma {
 			buf = append(buf, ',', '\n')
 		}
-		buf = append(buf, "READ_REPLICAS_SETTINGS = \""...)
-		buf = helpers.AppendWithEscape(buf, readReplicaSettings)
-		buf = append(buf, '"')
+		buf = appendReadReplicas(buf, readReplicas)
 	}
 	buf = append(buf, '\n', ')')
 
 	return string(buf)
 }
 
-func PrepareAlterRequest(diff *tableDiff) string {
+func PrepareAlterRequest(diff *tableDiff) (string, error) {
 	if diff == nil {
-		return ""
+		return "", nil
 	}
 
 	req := make([]byte, 0, defaultRequestCapacity)
 	needSemiColon := false
+	// Families go first: added columns may be placed in them.
+	if len(diff.FamiliesToAdd) > 0 || len(diff.FamiliesToAlter) > 0 {
+		needSemiColon = true
+		req = append(req, prepareFamiliesQuery(diff.TableName, diff.FamiliesToAdd, diff.FamiliesToAlter)...)
+	}
 	if len(diff.ColumnsToAdd) > 0 {
 		if needSemiColon {
 			req = append(req, ';', '\n')
 		}
 		needSemiColon = true
-		req = append(req, prepareAddColumnsQuery(diff.TableName, diff.ColumnsToAdd)...)
+		query, err := prepareAddColumnsQuery(diff.TableName, diff.ColumnsToAdd)
+		if err != nil {
+			return "", err
+		}
+		req = append(req, query...)
+	}
+	if len(diff.ColumnFamilyChanges) > 0 {
+		if needSemiColon {
+			req = append(req, ';', '\n')
+		}
+		needSemiColon = true
+		req = append(req, prepareAlterColumnFamiliesQuery(diff.TableName, diff.ColumnFamilyChanges)...)
 	}
 	if diff.NewTTLSettings != nil {
 		if needSemiColon {
@@ -442,14 +449,25 @@ func PrepareAlterRequest(diff *tableDiff) string {
 		req = append(req, prepareSetNewTTLSettingsQuery(diff.TableName, diff.NewTTLSettings)...)
 	}
 	if diff.OnlyResetTTL {
+		if needSemiColon {
+			req = append(req, ';', '\n')
+		}
 		needSemiColon = true
 		req = append(req, prepareResetTTLQuery(diff.TableName)...)
 	}
-	if diff.NewPartitioningSettings != nil || diff.ReadReplicasSettings != "" {
+	// Columns are dropped after the TTL change: the previous TTL column may be among them.
+	if len(diff.ColumnsToDrop) > 0 {
+		if needSemiColon {
+			req = append(req, ';', '\n')
+		}
+		needSemiColon = true
+		req = append(req, prepareDropColumnsQuery(diff.TableName, diff.ColumnsToDrop)...)
+	}
+	if diff.NewPartitioningSettings != nil || diff.ReadReplicas != nil {
 		if needSemiColon {
 			req = append(req, ';', '\n')
 		}
-		req = append(req, prepareNewPartitioningSettingsQuery(diff.TableName, diff.NewPartitioningSettings, diff.ReadReplicasSettings)...)
+		req = append(req, prepareNewPartitioningSettingsQuery(diff.TableName, diff.NewPartitioningSettings, diff.ReadReplicas)...)
 		needSemiColon = true
 	}
 
@@ -463,7 +481,7 @@ func PrepareAlterRequest(diff *tableDiff) string {
 
 	_ = needSemiColon
 
-	return string(req)
+	return string(req), nil
 }
 
 func PrepareDropTableRequest(tableName string) string {
@@ -473,3 +491,108 @@ func PrepareDropTableRequest(tableName string) string {
 	buf = append(buf, '`')
 	return string(buf)
 }
+
+func prepareSelectAllQuery(tableName string) string {
+	buf := make([]byte, 0, 64)
+	buf = append(buf, "SELECT * FROM `"...)
+	buf = helpers.AppendWithEscape(buf, tableName)
+	buf = append(buf, '`')
+	return string(buf)
+}
+
+func prepareCountRowsQuery(tableName string) string {
+	buf := make([]byte, 0, 64)
+	buf = append(buf, "SELECT COUNT(*) FROM `"...)
+	buf = helpers.AppendWithEscape(buf, tableName)
+	buf = append(buf, '`')
+	return string(buf)
+}
+
+func appendReadReplicas(buf []byte, r *ReadReplicas) []byte {
+	buf = append(buf, "READ_REPLICAS_SETTINGS = \""...)
+	buf = append(buf, r.String()...)
+	buf = append(buf, '"')
+	return buf
+}
+
+func appendFamilySetting(buf []byte, name, value string) []byte {
+	buf = append(buf, name...)
+	buf = append(buf, " = \""...)
+	buf = helpers.AppendWithEscape(buf, value)
+	buf = append(buf, '"')
+	return buf
+}
+
+func appendAlterFamily(buf []byte, needComma *bool, name string) []byte {
+	if *needComma {
+		buf = append(buf, ',', ' ')
+	}
+	*needComma = true
+	buf = append(buf, "ALTER FAMILY `"...)
+	buf = helpers.AppendWithEscape(buf, name)
+	buf = append(buf, "` SET "...)
+	return buf
+}
+
+func prepareFamiliesQuery(tableName string, toAdd []*Family, toAlter []*familyChange) string {
+	buf := make([]byte, 0, 128)
+	buf = append(buf, "ALTER TABLE `"...)
+	buf = helpers.AppendWithEscape(buf, tableName)
+	buf = append(buf, '`', ' ')
+	needComma := false
+	for _, f := range toAdd {
+		if needComma {
+			buf = append(buf, ',', ' ')
+		}
+		needComma = true
+		buf = append(buf, "ADD FAMILY `"...)
+		buf = helpers.AppendWithEscape(buf, f.Name)
+		buf = append(buf, "` ("...)
+		buf = appendFamilySetting(buf, "DATA", f.Data)
+		buf = append(buf, ", "...)
+		buf = appendFamilySetting(buf, "COMPRESSION", f.Compression)
+		if f.CompressionLevel != 0 {
+			buf = append(buf, ", COMPRESSION_LEVEL = "...)
+			buf = strconv.AppendInt(buf, int64(f.CompressionLevel), 10)
+		}
+		buf = append(buf, ')')
+	}
+	for _, c := range toAlter {
+		if c.Data != "" {
+			buf = appendAlterFamily(buf, &needComma, c.Name)
+			buf = append(buf, "DATA \""...)
+			buf = helpers.AppendWithEscape(buf, c.Data)
+			buf = append(buf, '"')
+		}
+		if c.Compression != "" {
+			buf = appendAlterFamily(buf, &needComma, c.Name)
+			buf = append(buf, "COMPRESSION \""...)
+			buf = helpers.AppendWithEscape(buf, c.Compression)
+			buf = append(buf, '"')
+		}
+		if c.CompressionLevel != 0 {
+			buf = appendAlterFamily(buf, &needComma, c.Name)
+			buf = append(buf, "COMPRESSION_LEVEL "...)
+			buf = strconv.AppendInt(buf, int64(c.CompressionLevel), 10)
+		}
+	}
+	return string(buf)
+}
+
+func prepareAlterColumnFamiliesQuery(tableName string, columns []*Column) string {
+	buf := make([]byte, 0, 128)
+	buf = append(buf, "ALTER TABLE `"...)
+	buf = helpers.AppendWithEscape(buf, tableName)
+	buf = append(buf, '`', ' ')
+	for i, c := range columns {
+		if i > 0 {
+			buf = append(buf, ',', ' ')
+		}
+		buf = append(buf, "ALTER COLUMN `"...)
+		buf = helpers.AppendWithEscape(buf, c.Name)
+		buf = append(buf, "` SET FAMILY `"...)
+		buf = helpers.AppendWithEscape(buf, c.Family)
+		buf = append(buf, '`')
+	}
+	return string(buf)
+}
@@ -2,9 +2,13 @@ package table
 
 import (
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
 )
 
 func TestPrepareCreateRequest(t *testing.T) {
@@ -139,6 +143,40 @@ func TestPrepareCreateRequest(t *testing.T) {
 				"\t)" + "\n" +
 				")\n",
 		},
+		{
+			testName: "column table with compression level",
+			resource: &Resource{
+				FullPath: "hello/world",
+				Columns: []*Column{
+					{
+						Name:    "mir",
+						Type:    "Utf8",
+						NotNull: true,
+						Family:  "archive",
+					},
+				},
+				PrimaryKey: &PrimaryKey{
+					Columns: []string{"mir"},
+				},
+				Family: []*Family{
+					{
+						Name:             "archive",
+						Data:             "ssd",
+						Compression:      "zstd",
+						CompressionLevel: 7,
+					},
+				},
+			},
+			expected: "CREATE TABLE `hello\\/world`(" + "\n" +
+				"\t`mir` Utf8 FAMILY `archive` NOT NULL," + "\n" +
+				"\tPRIMARY KEY (`mir`)," + "\n" +
+				"\tFAMILY `archive`(" + "\n" +
+				"\t\tDATA = \"ssd\"," + "\n" +
+				"\t\tCOMPRESSION = \"zstd\"," + "\n" +
+				"\t\tCOMPRESSION_LEVEL = 7" + "\n" +
+				"\t)" + "\n" +
+				")\n",
+		},
 		{
 			testName: "table with two columns with one as ttl",
 			resource: &Resource{
@@ -243,6 +281,42 @@ func TestPrepareCreateRequest(t *testing.T) {
 				"\tAUTO_PARTITIONING_MAX_PARTITIONS_COUNT = 42" + "\n" +
 				")",
 		},
+		{
+			testName: "table with partition at keys",
+			resource: &Resource{
+				FullPath: "hello/world",
+				Columns: []*Column{
+					{
+						Name: "ts",
+						Type: "Timestamp",
+					},
+					{
+						Name: "id",
+						Type: "Uuid",
+					},
+				},
+				PrimaryKey: &PrimaryKey{
+					Columns: []string{
+						"ts",
+						"id",
+					},
+				},
+				PartitioningSettings: &PartitioningSettings{
+					PartitionAtKeys: []*PartitionAtKeys{
+						{Keys: []types.Value{types.TimestampValueFromTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))}},
+						{Keys: []types.Value{nil, types.UuidValue(uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8"))}},
+					},
+				},
+			},
+			expected: "CREATE TABLE `hello\\/world`(" + "\n" +
+				"\t`ts` Timestamp," + "\n" +
+				"\t`id` Uuid," + "\n" +
+				"\tPRIMARY KEY (`ts`,`id`)" + "\n" +
+				")" + "\n" +
+				"WITH (" + "\n" +
+				"\tPARTITION_AT_KEYS = ((Timestamp(\"2024-01-01T00:00:00.000000Z\")),(NULL,Uuid(\"6ba7b810-9dad-11d1-80b4-00c04fd430c8\")))" + "\n" +
+				")",
+		},
 		{
 			testName: "table with replica settings",
 			resource: &Resource{
@@ -264,7 +338,7 @@ func TestPrepareCreateRequest(t *testing.T) {
 					},
 				},
 				ReplicationSettings: &ReplicationSettings{
-					ReadReplicasSettings: "PER_AZ",
+					ReadReplicas: &ReadReplicas{Mode: ReadReplicasModePerAZ, Count: 1},
 				},
 			},
 			expected: "CREATE TABLE `hello\\/world`(" + "\n" +
@@ -273,7 +347,7 @@ func TestPrepareCreateRequest(t *testing.T) {
 				"\tPRIMARY KEY (`mir`)" + "\n" +
 				")" + "\n" +
 				"WITH (" + "\n" +
-				"\tREAD_REPLICAS_SETTINGS = \"PER_AZ\"" + "\n" +
+				"\tREAD_REPLICAS_SETTINGS = \"PER_AZ:1\"" + "\n" +
 				")",
 		},
 		{
@@ -408,12 +482,45 @@ func TestPrepareCreateRequest(t *testing.T) {
 				"\tSTORE = ROW\n" +
 				")",
 		},
+		{
+			testName: "column table with tiered ttl",
+			resource: &Resource{
+				FullPath: "hello/world",
+				Columns: []*Column{
+					{
+						Name:    "ts",
+						Type:    "Timestamp",
+						NotNull: true,
+					},
+				},
+				PrimaryKey: &PrimaryKey{
+					Columns: []string{"ts"},
+				},
+				StoreType: options.StoreTypeColumn,
+				TTL: &TTL{
+					ColumnName: "ts",
+					Tiers: []TTLTier{
+						{ExpireInterval: "P1D", ExternalDataSource: "cold"},
+						{ExpireInterval: "P30D"},
+					},
+				},
+			},
+			expected: "CREATE TABLE `hello\\/world`(" + "\n" +
+				"\t`ts` Timestamp NOT NULL," + "\n" +
+				"\tPRIMARY KEY (`ts`)" + "\n" +
+				")" + "\n" +
+				"WITH (" + "\n" +
+				"\tSTORE = COLUMN,\n" +
+				"\tTTL = Interval(\"P1D\") TO EXTERNAL DATA SOURCE `cold`, Interval(\"P30D\") DELETE ON `ts`" + "\n" +
+				")",
+		},
 	}
 
 	for _, v := range testData {
 		v := v
 		t.Run(v.testName, func(t *testing.T) {
-			got := PrepareCreateRequest(v.resource)
+			got, err := PrepareCreateRequest(v.resource)
+			require.NoError(t, err)
 			assert.Equal(t, v.expected, got)
 		})
 	}
@@ -479,17 +586,65 @@ func TestPrepareAddColumnsQuery(t *testing.T) {
 				" ADD COLUMN `b` Uint8 FAMILY `some_family_2` NOT NULL," +
 				" ADD COLUMN `c` Uint16 FAMILY `some_family_3`",
 		},
+		{
+			testName:  "columns with defaults",
+			tableName: "abacaba",
+			columns: []*Column{
+				{
+					Name:    "status",
+					Type:    "Utf8",
+					NotNull: true,
+					Default: `say "new"`,
+				},
+				{
+					Name:    "attempts",
+					Type:    "Uint32",
+					Default: "3",
+				},
+			},
+			expected: "ALTER TABLE `abacaba` ADD COLUMN" +
+				" `status` Utf8 NOT NULL DEFAULT \"say \\\"new\\\"\"u," +
+				" ADD COLUMN `attempts` Uint32 DEFAULT 3u",
+		},
 	}
 
 	for _, v := range testData {
 		v := v
 		t.Run(v.testName, func(t *testing.T) {
-			got := prepareAddColumnsQuery(v.tableName, v.columns)
+			got, err := prepareAddColumnsQuery(v.tableName, v.columns)
+			require.NoError(t, err)
 			assert.Equal(t, v.expected, got)
 		})
 	}
 }
 
+func TestPrepareFamiliesQuery(t *testing.T) {
+	got := prepareFamiliesQuery("abacaba",
+		[]*Family{
+			{Name: "cold", Data: "rot", Compression: "lz4"},
+			{Name: "archive", Data: "rot", Compression: "zstd", CompressionLevel: 9},
+		},
+		[]*familyChange{
+			{Name: "default", Data: "ssd", Compression: "lz4"},
+			{Name: "hot", CompressionLevel: 3},
+		},
+	)
+	assert.Equal(t, "ALTER TABLE `abacaba` "+
+		"ADD FAMILY `cold` (DATA = \"rot\", COMPRESSION = \"lz4\"), "+
+		"ADD FAMILY `archive` (DATA = \"rot\", COMPRESSION = \"zstd\", COMPRESSION_LEVEL = 9), "+
+		"ALTER FAMILY `default` SET DATA \"ssd\", "+
+		"ALTER FAMILY `default` SET COMPRESSION \"lz4\", "+
+		"ALTER FAMILY `hot` SET COMPRESSION_LEVEL 3", got)
+}
+
+func TestPrepareAlterColumnFamiliesQuery(t *testing.T) {
+	got := prepareAlterColumnFamiliesQuery("abacaba", []*Column{
+		{Name: "a", Family: "cold"},
+		{Name: "b", Family: "default"},
+	})
+	assert.Equal(t, "ALTER TABLE `abacaba` ALTER COLUMN `a` SET FAMILY `cold`, ALTER COLUMN `b` SET FAMILY `default`", got)
+}
+
 func TestPrepareDropColumnsQuery(t *testing.T) {
 	testData := []struct {
 		testName  string
@@ -572,6 +727,20 @@ func TestPrepareSetNewTTLSettingsQuery(t *testing.T) {
 			},
 			expected: "ALTER TABLE `table` SET (TTL = Interval(\"Never\") ON `abacaba` AS seconds)",
 		},
+		{
+			testName:  "tiered ttl with integral type",
+			tableName: "table",
+			ttlSettings: &TTL{
+				ColumnName: "ts",
+				Unit:       "seconds",
+				Tiers: []TTLTier{
+					{ExpireInterval: "PT1H", ExternalDataSource: "warm"},
+					{ExpireInterval: "P1D", ExternalDataSource: "dir/cold"},
+				},
+			},
+			expected: "ALTER TABLE `table` SET (TTL = Interval(\"PT1H\") TO EXTERNAL DATA SOURCE `warm`," +
+				" Interval(\"P1D\") TO EXTERNAL DATA SOURCE `dir\\/cold` ON `ts` AS seconds)",
+		},
 	}
 
 	for _, v := range testData {
@@ -591,18 +760,18 @@ func TestPrepareNewPartitioningSettingsQuery(t *testing.T) {
 	partitioningBySizeFalse := false
 
 	testData := []struct {
-		testName            string
-		tableName           string
-		settings            *PartitioningSettings
-		readReplicaSettings string
-		expected            string
+		testName     string
+		tableName    string
+		settings     *PartitioningSettings
+		readReplicas *ReadReplicas
+		expected     string
 	}{
 		{
-			testName:            "only read_replica_settings are changed",
-			tableName:           "abacaba",
-			readReplicaSettings: "abacaba",
+			testName:     "only read_replica_settings are changed",
+			tableName:    "abacaba",
+			readReplicas: &ReadReplicas{Mode: ReadReplicasModeAnyAZ, Count: 2},
 			expected: "ALTER TABLE `abacaba` SET (\n" +
-				"READ_REPLICAS_SETTINGS = \"abacaba\"\n)",
+				"READ_REPLICAS_SETTINGS = \"ANY_AZ:2\"\n)",
 		},
 		{
 			testName:  "enable only partitioning_by_size",
@@ -673,21 +842,21 @@ func TestPrepareNewPartitioningSettingsQuery(t *testing.T) {
 				MinPartitionsCount: 4,
 				MaxPartitionsCount: 42,
 			},
-			readReplicaSettings: "abacaba",
+			readReplicas: &ReadReplicas{Mode: ReadReplicasModeAnyAZ, Count: 2},
 			expected: "ALTER TABLE `abacaba` SET (\n" +
 				"AUTO_PARTITIONING_BY_LOAD = ENABLED,\n" +
 				"AUTO_PARTITIONING_BY_SIZE = ENABLED,\n" +
 				"AUTO_PARTITIONING_PARTITION_SIZE_MB = 42,\n" +
 				"AUTO_PARTITIONING_MIN_PARTITIONS_COUNT = 4,\n" +
 				"AUTO_PARTITIONING_MAX_PARTITIONS_COUNT = 42,\n" +
-				"READ_REPLICAS_SETTINGS = \"abacaba\"\n)",
+				"READ_REPLICAS_SETTINGS = \"ANY_AZ:2\"\n)",
 		},
 	}
 
 	for _, v := range testData {
 		v := v
 		t.Run(v.testName, func(t *testing.T) {
-			got := prepareNewPartitioningSettingsQuery(v.tableName, v.settings, v.readReplicaSettings)
+			got := prepareNewPartitioningSettingsQuery(v.tableName, v.settings, v.readReplicas)
 			assert.Equal(t, v.expected, got)
 		})
 	}
@@ -748,11 +917,11 @@ func TestPrepareAlterRequest(t *testing.T) {
 		{
 			testName: "change only read_replicas_settings",
 			diff: &tableDiff{
-				TableName:            "abacaba",
-				ReadReplicasSettings: "abacaba",
+				TableName:    "abacaba",
+				ReadReplicas: &ReadReplicas{Mode: ReadReplicasModeAnyAZ, Count: 2},
 			},
 			expected: "ALTER TABLE `abacaba` SET (\n" +
-				"READ_REPLICAS_SETTINGS = \"abacaba\"\n)",
+				"READ_REPLICAS_SETTINGS = \"ANY_AZ:2\"\n)",
 		},
 		{
 			testName: "change only ttl settings",
@@ -766,6 +935,65 @@ func TestPrepareAlterRequest(t *testing.T) {
 			expected: "ALTER TABLE `abacaba` RESET (TTL);\n" +
 				"ALTER TABLE `abacaba` SET (TTL = Interval(\"PT0S\") ON `d`)",
 		},
+		{
+			testName: "change ttl to tiers",
+			diff: &tableDiff{
+				TableName: "abacaba",
+				NewTTLSettings: &TTL{
+					ColumnName: "ts",
+					Tiers: []TTLTier{
+						{ExpireInterval: "P1D", ExternalDataSource: "cold"},
+						{ExpireInterval: "P30D"},
+					},
+				},
+			},
+			expected: "ALTER TABLE `abacaba` RESET (TTL);\n" +
+				"ALTER TABLE `abacaba` SET (TTL = Interval(\"P1D\") TO EXTERNAL DATA SOURCE `cold`, Interval(\"P30D\") DELETE ON `ts`)",
+		},
+		{
+			testName: "add columns and reset ttl",
+			diff: &tableDiff{
+				TableName: "abacaba",
+				ColumnsToAdd: []*Column{
+					{Name: "a", Type: "Bool"},
+				},
+				OnlyResetTTL: true,
+			},
+			expected: "ALTER TABLE `abacaba` ADD COLUMN `a` Bool;\n" +
+				"ALTER TABLE `abacaba` RESET (TTL)",
+		},
+		{
+			testName: "drop columns after the ttl change",
+			diff: &tableDiff{
+				TableName:     "abacaba",
+				ColumnsToDrop: []string{"b", "old_ts"},
+				NewTTLSettings: &TTL{
+					ColumnName:     "ts",
+					ExpireInterval: "P1D",
+				},
+			},
+			expected: "ALTER TABLE `abacaba` RESET (TTL);\n" +
+				"ALTER TABLE `abacaba` SET (TTL = Interval(\"P1D\") ON `ts`);\n" +
+				"ALTER TABLE `abacaba` DROP COLUMN `b`, DROP COLUMN `old_ts`",
+		},
+		{
+			testName: "add family before columns placed in it",
+			diff: &tableDiff{
+				TableName: "abacaba",
+				ColumnsToAdd: []*Column{
+					{Name: "a", Type: "Utf8", Family: "cold"},
+				},
+				ColumnFamilyChanges: []*Column{
+					{Name: "b", Family: "cold"},
+				},
+				FamiliesToAdd: []*Family{
+					{Name: "cold", Data: "rot", Compression: "lz4"},
+				},
+			},
+			expected: "ALTER TABLE `abacaba` ADD FAMILY `cold` (DATA = \"rot\", COMPRESSION = \"lz4\");\n" +
+				"ALTER TABLE `abacaba` ADD COLUMN `a` Utf8 FAMILY `cold`;\n" +
+				"ALTER TABLE `abacaba` ALTER COLUMN `b` SET FAMILY `cold`",
+		},
 		{
 			testName: "change all settings",
 			diff: &tableDiff{
@@ -795,7 +1023,7 @@ func TestPrepareAlterRequest(t *testing.T) {
 					MinPartitionsCount: 4,
 					MaxPartitionsCount: 42,
 				},
-				ReadReplicasSettings: "abacaba",
+				ReadReplicas: &ReadReplicas{Mode: ReadReplicasModeAnyAZ, Count: 2},
 			},
 			expected: "ALTER TABLE `abacaba` ADD COLUMN `a` Bool FAMILY `my_family` NOT NULL, ADD COLUMN `b` Utf8 FAMILY `my_family` NOT NULL;\n" +
 				"ALTER TABLE `abacaba` RESET (TTL);\n" +
@@ -806,14 +1034,15 @@ func TestPrepareAlterRequest(t *testing.T) {
 				"AUTO_PARTITIONING_PARTITION_SIZE_MB = 42,\n" +
 				"AUTO_PARTITIONING_MIN_PARTITIONS_COUNT = 4,\n" +
 				"AUTO_PARTITIONING_MAX_PARTITIONS_COUNT = 42,\n" +
-				"READ_REPLICAS_SETTINGS = \"abacaba\"\n)",
+				"READ_REPLICAS_SETTINGS = \"ANY_AZ:2\"\n)",
 		},
 	}
 
 	for _, v := range testData {
 		v := v
 		t.Run(v.testName, func(t *testing.T) {
-			got := PrepareAlterRequest(v.diff)
+			got, err := PrepareAlterRequest(v.diff)
+			require.NoError(t, err)
 			assert.Equal(t, v.expected, got)
 		})
 	}
@@ -0,0 +1,51 @@
+package tablestore
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func (h *handler) Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connectionString := d.Get("connection_string").(string)
+	storePath := d.Get("path").(string)
+
+	_, database, _, err := helpers.ParseYDBDatabaseEndpoint(connectionString)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: connectionString,
+		AuthCreds:        h.authCreds,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: "failed to initialize DB connection", Detail: err.Error()},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	q := prepareCreateTablestoreQuery(
+		helpers.JoinYDBCatalogPath(database, storePath),
+		expandColumns(d.Get("column")),
+		expandPrimaryKey(d),
+		d.Get("shards_count").(int),
+	)
+	err = db.ExecuteSchemeQuery(ctx, q, tbl.NonIdempotent)
+	if err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: "failed to execute query CREATE TABLESTORE ...", Detail: err.Error()},
+		}
+	}
+
+	d.SetId(connectionString + "?path=" + helpers.TrimPath(storePath))
+
+	return h.Read(ctx, d, meta)
+}
@@ -0,0 +1,61 @@
+package tablestore
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ydb "github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/scheme"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func (h *handler) Read(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	entity, err := helpers.ParseYDBEntityID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: entity.PrepareFullYDBEndpoint(),
+		AuthCreds:        h.authCreds,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: "failed to initialize table client", Detail: err.Error()},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	entry, err := db.Scheme().DescribePath(ctx, entity.GetFullEntityPath())
+	if err != nil {
+		if ydb.IsOperationErrorSchemeError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to describe path %q: %s", entity.GetFullEntityPath(), err)
+	}
+	if entry.Type != scheme.EntryColumnStore {
+		return diag.Errorf("path %q is a %s, not a tablestore", entity.GetFullEntityPath(), entry.Type)
+	}
+
+	desc, err := describeTablestore(ctx, db, entity.GetFullEntityPath())
+	if err != nil {
+		return diag.Errorf("failed to describe tablestore %q: %s", entity.GetFullEntityPath(), err)
+	}
+
+	_ = d.Set("connection_string", entity.PrepareFullYDBEndpoint())
+	_ = d.Set("path", entity.GetEntityPath())
+	_ = d.Set("full_path", entity.GetFullEntityPath())
+	_ = d.Set("column", flattenColumns(desc, expandColumns(d.Get("column"))))
+	_ = d.Set("primary_key", desc.PrimaryKey)
+	if n := desc.PartitioningSettings.MinPartitionsCount; n > 0 {
+		_ = d.Set("shards_count", int(n))
+	}
+
+	return nil
+}
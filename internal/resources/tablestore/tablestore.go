@@ -0,0 +1,146 @@
+package tablestore
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+type Column struct {
+	Name    string
+	Type    string
+	NotNull bool
+}
+
+func (c *Column) ToYQL() string {
+	buf := make([]byte, 0, 64)
+	buf = append(buf, '`')
+	buf = helpers.AppendWithEscape(buf, c.Name)
+	buf = append(buf, '`', ' ')
+	buf = helpers.AppendWithEscape(buf, c.Type)
+	if c.NotNull {
+		buf = append(buf, " NOT NULL"...)
+	}
+	return string(buf)
+}
+
+func expandColumns(cols interface{}) []*Column {
+	raw := cols.(*schema.Set).List()
+	columns := make([]*Column, 0, len(raw))
+	for _, v := range raw {
+		m := v.(map[string]interface{})
+		columns = append(columns, &Column{
+			Name:    m["name"].(string),
+			Type:    m["type"].(string),
+			NotNull: m["not_null"].(bool),
+		})
+	}
+	// Sets have no stable order, keep queries deterministic.
+	sort.Slice(columns, func(i, j int) bool { return columns[i].Name < columns[j].Name })
+	return columns
+}
+
+func expandPrimaryKey(d *schema.ResourceData) []string {
+	raw := d.Get("primary_key").([]interface{})
+	pk := make([]string, 0, len(raw))
+	for _, v := range raw {
+		pk = append(pk, v.(string))
+	}
+	return pk
+}
+
+// flattenColumns converts the described columns. YDB describes types in PascalCase, so the
+// configured spelling of an equal type is kept: the type is part of the column hash.
+func flattenColumns(desc options.Description, configured []*Column) []interface{} {
+	configuredTypes := make(map[string]string, len(configured))
+	for _, c := range configured {
+		configuredTypes[c.Name] = c.Type
+	}
+
+	cols := make([]interface{}, 0, len(desc.Columns))
+	for _, col := range desc.Columns {
+		typ := col.Type.Yql()
+		notNull := true
+		if strings.HasPrefix(typ, "Optional<") {
+			notNull = false
+			typ = strings.TrimSuffix(strings.TrimPrefix(typ, "Optional<"), ">")
+		}
+		if c, ok := configuredTypes[col.Name]; ok && strings.EqualFold(c, typ) {
+			typ = c
+		}
+		cols = append(cols, map[string]interface{}{
+			"name":     col.Name,
+			"type":     typ,
+			"not_null": notNull,
+		})
+	}
+	return cols
+}
+
+// columnsDiff returns the columns to add and the names of the columns to drop. Existing columns
+// cannot be changed in place.
+func columnsDiff(oldColumns, newColumns []*Column) (toAdd []*Column, toDrop []string, err error) {
+	existing := make(map[string]*Column, len(oldColumns))
+	for _, c := range oldColumns {
+		existing[c.Name] = c
+	}
+	wanted := make(map[string]struct{}, len(newColumns))
+	for _, c := range newColumns {
+		wanted[c.Name] = struct{}{}
+		old, ok := existing[c.Name]
+		if !ok {
+			toAdd = append(toAdd, c)
+			continue
+		}
+		if !strings.EqualFold(old.Type, c.Type) || old.NotNull != c.NotNull {
+			return nil, nil, fmt.Errorf("changing column %q of a tablestore is not supported: YDB can only add and drop tablestore columns", c.Name)
+		}
+	}
+	for _, c := range oldColumns {
+		if _, ok := wanted[c.Name]; !ok {
+			toDrop = append(toDrop, c.Name)
+		}
+	}
+	return toAdd, toDrop, nil
+}
+
+// ValidateResourceDiff rejects column changes YDB cannot apply to a tablestore at plan time.
+func ValidateResourceDiff(d *schema.ResourceDiff) error {
+	if !d.HasChange("column") {
+		return nil
+	}
+	o, n := d.GetChange("column")
+	_, toDrop, err := columnsDiff(expandColumns(o), expandColumns(n))
+	if err != nil {
+		return err
+	}
+	pk := make(map[string]struct{})
+	for _, c := range d.Get("primary_key").([]interface{}) {
+		pk[c.(string)] = struct{}{}
+	}
+	for _, name := range toDrop {
+		if _, ok := pk[name]; ok {
+			return fmt.Errorf("primary key column %q of a tablestore cannot be dropped", name)
+		}
+	}
+	return nil
+}
+
+// describeTablestore describes the schema of a tablestore. The table service serves OLAP
+// objects too: it reports the columns and primary key shared by the tables of the store.
+func describeTablestore(ctx context.Context, db *tbl.Driver, fullPath string) (options.Description, error) {
+	var desc options.Description
+	err := db.Table().Do(ctx, func(ctx context.Context, s table.Session) (err error) {
+		desc, err = s.DescribeTable(ctx, fullPath, options.WithTableStats())
+		return err
+	})
+	return desc, err
+}
@@ -0,0 +1,63 @@
+package tablestore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/types"
+)
+
+func TestPrepareCreateTablestoreQuery(t *testing.T) {
+	q := prepareCreateTablestoreQuery("/Root/db/olap", []*Column{
+		{Name: "id", Type: "Uint64", NotNull: true},
+		{Name: "value", Type: "Utf8"},
+	}, []string{"id"}, 4)
+	assert.Equal(t, "CREATE TABLESTORE `/Root/db/olap` (\n"+
+		"\t`id` Uint64 NOT NULL,\n"+
+		"\t`value` Utf8,\n"+
+		"\tPRIMARY KEY (`id`)\n"+
+		")\n"+
+		"WITH (\n"+
+		"\tSTORE = COLUMN,\n"+
+		"\tAUTO_PARTITIONING_MIN_PARTITIONS_COUNT = 4\n"+
+		")", q)
+}
+
+func TestPrepareAlterColumnsQuery(t *testing.T) {
+	q := prepareAlterColumnsQuery("/Root/db/olap", []*Column{{Name: "ts", Type: "Timestamp"}}, []string{"value"})
+	assert.Equal(t, "ALTER TABLESTORE `/Root/db/olap` ADD COLUMN `ts` Timestamp, DROP COLUMN `value`", q)
+}
+
+func TestColumnsDiff(t *testing.T) {
+	oldColumns := []*Column{{Name: "id", Type: "Uint64", NotNull: true}, {Name: "value", Type: "Utf8"}}
+
+	toAdd, toDrop, err := columnsDiff(oldColumns, []*Column{
+		{Name: "id", Type: "uint64", NotNull: true},
+		{Name: "ts", Type: "Timestamp"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*Column{{Name: "ts", Type: "Timestamp"}}, toAdd)
+	assert.Equal(t, []string{"value"}, toDrop)
+
+	_, _, err = columnsDiff(oldColumns, []*Column{{Name: "id", Type: "Uint64", NotNull: true}, {Name: "value", Type: "String"}})
+	assert.Error(t, err)
+}
+
+func TestFlattenColumns(t *testing.T) {
+	desc := options.Description{Columns: []options.Column{
+		{Name: "id", Type: types.TypeUint64},
+		{Name: "value", Type: types.Optional(types.TypeUTF8)},
+		{Name: "ts", Type: types.Optional(types.TypeTimestamp)},
+	}}
+	cols := flattenColumns(desc, []*Column{
+		{Name: "id", Type: "uint64", NotNull: true},
+		{Name: "value", Type: "String"},
+	})
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"name": "id", "type": "uint64", "not_null": true},
+		map[string]interface{}{"name": "value", "type": "Utf8", "not_null": false},
+		map[string]interface{}{"name": "ts", "type": "Timestamp", "not_null": false},
+	}, cols)
+}
@@ -0,0 +1,54 @@
+package tablestore
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func (h *handler) Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.HasChange("column") {
+		return h.Read(ctx, d, meta)
+	}
+
+	o, n := d.GetChange("column")
+	toAdd, toDrop, err := columnsDiff(expandColumns(o), expandColumns(n))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(toAdd) == 0 && len(toDrop) == 0 {
+		return h.Read(ctx, d, meta)
+	}
+
+	entity, err := helpers.ParseYDBEntityID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: entity.PrepareFullYDBEndpoint(),
+		AuthCreds:        h.authCreds,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: "failed to initialize table client", Detail: err.Error()},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	q := prepareAlterColumnsQuery(entity.GetFullEntityPath(), toAdd, toDrop)
+	err = db.ExecuteSchemeQuery(ctx, q, tbl.NonIdempotent)
+	if err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: "failed to execute query ALTER TABLESTORE ...", Detail: err.Error()},
+		}
+	}
+
+	return h.Read(ctx, d, meta)
+}
@@ -0,0 +1,51 @@
+package tablestore
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+)
+
+func prepareCreateTablestoreQuery(fullPath string, columns []*Column, primaryKey []string, shardsCount int) string {
+	var b strings.Builder
+	b.WriteString("CREATE TABLESTORE `")
+	b.WriteString(helpers.EscapeYQLIdentifier(fullPath))
+	b.WriteString("` (\n")
+	for _, c := range columns {
+		b.WriteString("\t")
+		b.WriteString(c.ToYQL())
+		b.WriteString(",\n")
+	}
+	b.WriteString("\tPRIMARY KEY (")
+	for i, c := range primaryKey {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString("`")
+		b.WriteString(helpers.EscapeYQLIdentifier(c))
+		b.WriteString("`")
+	}
+	b.WriteString(")\n)\nWITH (\n\tSTORE = COLUMN")
+	if shardsCount > 0 {
+		b.WriteString(",\n\tAUTO_PARTITIONING_MIN_PARTITIONS_COUNT = ")
+		b.WriteString(strconv.Itoa(shardsCount))
+	}
+	b.WriteString("\n)")
+	return b.String()
+}
+
+func prepareAlterColumnsQuery(fullPath string, toAdd []*Column, toDrop []string) string {
+	actions := make([]string, 0, len(toAdd)+len(toDrop))
+	for _, c := range toAdd {
+		actions = append(actions, "ADD COLUMN "+c.ToYQL())
+	}
+	for _, name := range toDrop {
+		actions = append(actions, "DROP COLUMN `"+helpers.EscapeYQLIdentifier(name)+"`")
+	}
+	return "ALTER TABLESTORE `" + helpers.EscapeYQLIdentifier(fullPath) + "` " + strings.Join(actions, ", ")
+}
+
+func prepareDropTablestoreQuery(fullPath string) string {
+	return "DROP TABLESTORE `" + helpers.EscapeYQLIdentifier(fullPath) + "`"
+}
@@ -0,0 +1,60 @@
+package transfer
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func (h *handler) Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	connectionString := d.Get("connection_string").(string)
+	transferPath := d.Get("path").(string)
+
+	_, database, _, err := helpers.ParseYDBDatabaseEndpoint(connectionString)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	fullPath := helpers.JoinYDBCatalogPath(database, transferPath)
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: connectionString,
+		AuthCreds:        h.authCreds,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: "failed to initialize DB connection", Detail: err.Error()},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	q := prepareCreateTransferQuery(fullPath,
+		d.Get("source_topic").(string),
+		d.Get("target_table").(string),
+		d.Get("transformation_lambda").(string),
+		createSettings(d),
+	)
+	err = db.ExecQuery(ctx, q, tbl.NonIdempotent)
+	if err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: "failed to execute `CREATE TRANSFER ...`", Detail: err.Error()},
+		}
+	}
+	d.SetId(connectionString + "?path=" + helpers.TrimPath(transferPath))
+
+	if d.Get("state").(string) == StatePaused {
+		err = db.ExecQuery(ctx, prepareAlterTransferQuery(fullPath, stateSettings(StatePaused)), tbl.Idempotent)
+		if err != nil {
+			return diag.Diagnostics{
+				{Severity: diag.Error, Summary: "failed to pause transfer", Detail: err.Error()},
+			}
+		}
+	}
+
+	return h.Read(ctx, d, meta)
+}
@@ -0,0 +1,91 @@
+package transfer
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func (h *handler) Read(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	entity, err := helpers.ParseYDBEntityID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: entity.PrepareFullYDBEndpoint(),
+		AuthCreds:        h.authCreds,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: "failed to initialize table client", Detail: err.Error()},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	desc, err := describeTransfer(ctx, db, entity.GetFullEntityPath())
+	if err != nil {
+		if helpers.IsSchemeErrorStatus(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to describe transfer %q: %s", entity.GetFullEntityPath(), err)
+	}
+
+	_ = d.Set("connection_string", entity.PrepareFullYDBEndpoint())
+	_ = d.Set("path", entity.GetEntityPath())
+
+	sourceDatabase := entity.GetDatabasePath()
+	if src, ok := helpers.ExpandReplicationSource(d); ok {
+		params := desc.GetConnectionParams()
+		if params.GetDatabase() != "" {
+			sourceDatabase = params.GetDatabase()
+		}
+		_ = d.Set("source", []interface{}{map[string]interface{}{
+			"connection_string":    src.ConnectionString,
+			"token_secret_name":    params.GetOauth().GetTokenSecretName(),
+			"user":                 params.GetStaticCredentials().GetUser(),
+			"password_secret_name": params.GetStaticCredentials().GetPasswordSecretName(),
+		}})
+	}
+	_ = d.Set("source_topic", pathState(d.Get("source_topic").(string), desc.GetSourcePath(), sourceDatabase))
+	_ = d.Set("target_table", pathState(d.Get("target_table").(string), desc.GetDestinationPath(), entity.GetDatabasePath()))
+	_ = d.Set("consumer", desc.GetConsumerName())
+	if lambdaChanged(d.Get("transformation_lambda").(string), desc.GetTransformationLambda()) {
+		_ = d.Set("transformation_lambda", desc.GetTransformationLambda())
+	}
+
+	if batch := desc.GetBatchSettings(); batch != nil {
+		_ = d.Set("batch_size_bytes", int(batch.GetSizeBytes()))
+		interval := batch.GetFlushInterval().AsDuration()
+		if configured, err := time.ParseDuration(d.Get("flush_interval").(string)); err != nil || configured != interval {
+			_ = d.Set("flush_interval", interval.String())
+		}
+	}
+
+	status, issues := transferStatus(desc)
+	if status == "paused" {
+		_ = d.Set("state", StatePaused)
+	} else {
+		_ = d.Set("state", StateRunning)
+	}
+	_ = d.Set("status", status)
+	_ = d.Set("errors", issues)
+
+	return nil
+}
+
+// pathState keeps the configured spelling of a path if it points to the described one.
+func pathState(configured, described, database string) string {
+	if described == "" || helpers.JoinYDBCatalogPath(database, configured) == described {
+		return configured
+	}
+	return helpers.RelativizeYDBCatalogPath(database, described)
+}
@@ -0,0 +1,103 @@
+package transfer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ydb-platform/ydb-go-genproto/draft/Ydb_Replication_V1"
+	"github.com/ydb-platform/ydb-go-genproto/draft/protos/Ydb_Replication"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	ydb "github.com/ydb-platform/ydb-go-sdk/v3"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	"github.com/ydb-platform/terraform-provider-ydb/internal/retry"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+// Desired states of a transfer.
+const (
+	StateRunning = "RUNNING"
+	StatePaused  = "PAUSED"
+)
+
+func createSettings(d *schema.ResourceData) []helpers.YQLSetting {
+	var res []helpers.YQLSetting
+	if src, ok := helpers.ExpandReplicationSource(d); ok {
+		res = append(res, helpers.YQLStringSetting("CONNECTION_STRING", src.ConnectionString))
+		res = append(res, src.CredentialSettings()...)
+	}
+	if v := d.Get("consumer").(string); v != "" {
+		res = append(res, helpers.YQLStringSetting("CONSUMER", v))
+	}
+	return append(res, batchSettings(d, false)...)
+}
+
+// batchSettings returns the configured batch settings, or only the changed ones.
+func batchSettings(d *schema.ResourceData, changedOnly bool) []helpers.YQLSetting {
+	var res []helpers.YQLSetting
+	if v := d.Get("batch_size_bytes").(int); v > 0 && (!changedOnly || d.HasChange("batch_size_bytes")) {
+		res = append(res, helpers.YQLIntSetting("BATCH_SIZE_BYTES", v))
+	}
+	if v := d.Get("flush_interval").(string); v != "" && (!changedOnly || d.HasChange("flush_interval")) {
+		interval, _ := time.ParseDuration(v)
+		res = append(res, helpers.YQLIntervalSetting("FLUSH_INTERVAL", interval))
+	}
+	return res
+}
+
+// describeTransfer describes the transfer at fullPath. The SDK has no transfer client, so the
+// request goes to the replication service directly.
+func describeTransfer(ctx context.Context, db *tbl.Driver, fullPath string) (*Ydb_Replication.DescribeTransferResult, error) {
+	client := Ydb_Replication_V1.NewReplicationServiceClient(ydb.GRPCConn(db.Driver))
+	result := &Ydb_Replication.DescribeTransferResult{}
+	err := db.Retry(ctx, "describe transfer", tbl.Idempotent, func(ctx context.Context) error {
+		resp, err := client.DescribeTransfer(ctx, &Ydb_Replication.DescribeTransferRequest{Path: fullPath})
+		if err != nil {
+			return fmt.Errorf("describe_transfer problem: %w", err)
+		}
+		op := resp.GetOperation()
+		if op.GetStatus() != Ydb.StatusIds_SUCCESS {
+			return fmt.Errorf("describe transfer operation code not success: %w", &retry.StatusError{Status: op.GetStatus(), Issues: op.GetIssues()})
+		}
+		if err := op.GetResult().UnmarshalTo(result); err != nil {
+			return fmt.Errorf("unmarshal_to problem: %w", err)
+		}
+		return nil
+	})
+	return result, err
+}
+
+// transferStatus returns the observed state of a transfer and the issues of a failed one.
+func transferStatus(desc *Ydb_Replication.DescribeTransferResult) (string, []string) {
+	switch {
+	case desc.GetRunning() != nil:
+		return "running", nil
+	case desc.GetError() != nil:
+		return "error", helpers.IssueMessages(desc.GetError().GetIssues())
+	case desc.GetDone() != nil:
+		return "done", nil
+	case desc.GetPaused() != nil:
+		return "paused", nil
+	}
+	return "unknown", nil
+}
+
+// normalizeLambda strips surrounding whitespace and trailing semicolons.
+func normalizeLambda(lambda string) string {
+	return strings.TrimSpace(strings.TrimRight(strings.TrimSpace(lambda), ";"))
+}
+
+// SuppressLambdaDiff ignores differences that normalizeLambda removes.
+func SuppressLambdaDiff(_, oldValue, newValue string, _ *schema.ResourceData) bool {
+	return normalizeLambda(oldValue) == normalizeLambda(newValue)
+}
+
+// lambdaChanged reports whether the lambda stored by YDB differs from the configured one. YDB
+// may keep the whole statement declaring the lambda, so containing the configured text is enough.
+func lambdaChanged(configured, stored string) bool {
+	configured = normalizeLambda(configured)
+	return configured == "" || !strings.Contains(stored, configured)
+}
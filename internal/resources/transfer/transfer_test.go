@@ -0,0 +1,90 @@
+package transfer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ydb-platform/ydb-go-genproto/draft/protos/Ydb_Replication"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+)
+
+const testLambda = `($msg) -> {
+    return [<| offset: $msg._offset, message: CAST($msg._data AS Utf8) |>];
+};`
+
+func TestPrepareTransferQueries(t *testing.T) {
+	declaration := "$transformation_lambda = ($msg) -> {\n    return [<| offset: $msg._offset, message: CAST($msg._data AS Utf8) |>];\n};\n"
+
+	testData := []struct {
+		testName string
+		got      string
+		expected string
+	}{
+		{
+			testName: "create",
+			got: prepareCreateTransferQuery("/local/transfers/events", "events", "events_table", testLambda, []helpers.YQLSetting{
+				helpers.YQLStringSetting("CONSUMER", "transfer"),
+				helpers.YQLIntSetting("BATCH_SIZE_BYTES", 1048576),
+				helpers.YQLIntervalSetting("FLUSH_INTERVAL", time.Minute),
+			}),
+			expected: declaration + "CREATE TRANSFER `/local/transfers/events` FROM `events` TO `events_table` USING $transformation_lambda " +
+				"WITH (CONSUMER = 'transfer', BATCH_SIZE_BYTES = 1048576, FLUSH_INTERVAL = Interval('PT60S'))",
+		},
+		{
+			testName: "create without settings",
+			got:      prepareCreateTransferQuery("/local/t", "topic", "table", "($msg) -> { return []; }", nil),
+			expected: "$transformation_lambda = ($msg) -> { return []; };\nCREATE TRANSFER `/local/t` FROM `topic` TO `table` USING $transformation_lambda",
+		},
+		{
+			testName: "alter lambda",
+			got:      prepareAlterLambdaQuery("/local/transfers/events", testLambda),
+			expected: declaration + "ALTER TRANSFER `/local/transfers/events` SET USING $transformation_lambda",
+		},
+		{
+			testName: "pause",
+			got:      prepareAlterTransferQuery("/local/t", stateSettings(StatePaused)),
+			expected: "ALTER TRANSFER `/local/t` SET (STATE = 'Paused')",
+		},
+		{
+			testName: "resume",
+			got:      prepareAlterTransferQuery("/local/t", stateSettings(StateRunning)),
+			expected: "ALTER TRANSFER `/local/t` SET (STATE = 'StandBy')",
+		},
+		{
+			testName: "drop",
+			got:      prepareDropTransferQuery("/local/t"),
+			expected: "DROP TRANSFER `/local/t`",
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.testName, func(t *testing.T) {
+			assert.Equal(t, v.expected, v.got)
+		})
+	}
+}
+
+func TestLambdaChanged(t *testing.T) {
+	assert.False(t, lambdaChanged(testLambda, normalizeLambda(testLambda)))
+	assert.False(t, lambdaChanged(testLambda+"\n", "$transformation_lambda = "+normalizeLambda(testLambda)+";"))
+	assert.True(t, lambdaChanged("($msg) -> { return []; }", normalizeLambda(testLambda)))
+	assert.True(t, SuppressLambdaDiff("transformation_lambda", testLambda, "  "+normalizeLambda(testLambda), nil))
+}
+
+func TestPathState(t *testing.T) {
+	assert.Equal(t, "events", pathState("events", "/local/events", "/local"))
+	assert.Equal(t, "/local/events", pathState("/local/events", "/local/events", "/local"))
+	assert.Equal(t, "other/events", pathState("events", "/local/other/events", "/local"))
+	assert.Equal(t, "events", pathState("", "/local/events", "/local"))
+}
+
+func TestTransferStatus(t *testing.T) {
+	paused := &Ydb_Replication.DescribeTransferResult{State: &Ydb_Replication.DescribeTransferResult_Paused{
+		Paused: &Ydb_Replication.DescribeTransferResult_PausedState{},
+	}}
+	status, issues := transferStatus(paused)
+	assert.Equal(t, "paused", status)
+	assert.Empty(t, issues)
+}
@@ -0,0 +1,64 @@
+package transfer
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func (h *handler) Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	entity, err := helpers.ParseYDBEntityID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	fullPath := entity.GetFullEntityPath()
+
+	var queries []string
+	if d.HasChange("transformation_lambda") {
+		queries = append(queries, prepareAlterLambdaQuery(fullPath, d.Get("transformation_lambda").(string)))
+	}
+	var opts []helpers.YQLSetting
+	if d.HasChanges("source.0.token_secret_name", "source.0.user", "source.0.password_secret_name") {
+		src, _ := helpers.ExpandReplicationSource(d)
+		opts = append(opts, src.CredentialSettings()...)
+	}
+	opts = append(opts, batchSettings(d, true)...)
+	if len(opts) > 0 {
+		queries = append(queries, prepareAlterTransferQuery(fullPath, opts))
+	}
+	// The state goes last, so a transfer is resumed with the new settings.
+	if d.HasChange("state") {
+		queries = append(queries, prepareAlterTransferQuery(fullPath, stateSettings(d.Get("state").(string))))
+	}
+	if len(queries) == 0 {
+		return h.Read(ctx, d, meta)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: entity.PrepareFullYDBEndpoint(),
+		AuthCreds:        h.authCreds,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: "failed to initialize table client", Detail: err.Error()},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	for _, q := range queries {
+		err = db.ExecQuery(ctx, q, tbl.Idempotent)
+		if err != nil {
+			return diag.Diagnostics{
+				{Severity: diag.Error, Summary: "failed to execute query ALTER TRANSFER ...", Detail: err.Error()},
+			}
+		}
+	}
+
+	return h.Read(ctx, d, meta)
+}
@@ -0,0 +1,37 @@
+package transfer
+
+import (
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+)
+
+const lambdaName = "$transformation_lambda"
+
+func declareLambda(lambda string) string {
+	return lambdaName + " = " + normalizeLambda(lambda) + ";\n"
+}
+
+func prepareCreateTransferQuery(fullPath, sourceTopic, targetTable, lambda string, opts []helpers.YQLSetting) string {
+	return declareLambda(lambda) +
+		"CREATE TRANSFER `" + helpers.EscapeYQLIdentifier(fullPath) + "` FROM `" + helpers.EscapeYQLIdentifier(sourceTopic) +
+		"` TO `" + helpers.EscapeYQLIdentifier(targetTable) + "` USING " + lambdaName + helpers.PrepareYQLWithClause(opts)
+}
+
+func prepareAlterTransferQuery(fullPath string, opts []helpers.YQLSetting) string {
+	return "ALTER TRANSFER `" + helpers.EscapeYQLIdentifier(fullPath) + "` " + helpers.PrepareYQLSetResetClause(opts)
+}
+
+func prepareAlterLambdaQuery(fullPath, lambda string) string {
+	return declareLambda(lambda) + "ALTER TRANSFER `" + helpers.EscapeYQLIdentifier(fullPath) + "` SET USING " + lambdaName
+}
+
+// stateSettings pause the transfer or resume a paused one.
+func stateSettings(state string) []helpers.YQLSetting {
+	if state == StatePaused {
+		return []helpers.YQLSetting{helpers.YQLStringSetting("STATE", "Paused")}
+	}
+	return []helpers.YQLSetting{helpers.YQLStringSetting("STATE", "StandBy")}
+}
+
+func prepareDropTransferQuery(fullPath string) string {
+	return "DROP TRANSFER `" + helpers.EscapeYQLIdentifier(fullPath) + "`"
+}
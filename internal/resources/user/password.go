@@ -0,0 +1,30 @@
+package user
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+)
+
+// resolvePasswordOption returns the CREATE/ALTER USER option setting the configured password, or
+// an empty string when the user has no password.
+func resolvePasswordOption(ctx context.Context, d *schema.ResourceData) (string, error) {
+	if v, ok := d.GetOk("password"); ok {
+		return passwordOption(v.(string)), nil
+	}
+	if v, ok := d.GetOk("password_hash"); ok {
+		return hashOption(v.(string)), nil
+	}
+	if cmdList := d.Get("password_command").([]interface{}); len(cmdList) > 0 && cmdList[0] != nil {
+		out, err := helpers.RunCommand(ctx, cmdList[0].(map[string]interface{}))
+		if err != nil {
+			return "", err
+		}
+		// Commands like `cat` or `echo` end their output with a newline that is not part of the password.
+		return passwordOption(strings.TrimRight(out, "\r\n")), nil
+	}
+	return "", nil
+}
@@ -0,0 +1,58 @@
+package user
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func (h *handler) Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	entity, err := helpers.ParseYDBEntityID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var options []string
+	if d.HasChanges("password", "password_hash", "password_command") {
+		password, err := resolvePasswordOption(ctx, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if password == "" {
+			password = noPasswordOption
+		}
+		options = append(options, password)
+	}
+	if d.HasChange("login") {
+		options = append(options, loginOption(d.Get("login").(bool)))
+	}
+	if len(options) == 0 {
+		return h.Read(ctx, d, meta)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: entity.PrepareFullYDBEndpoint(),
+		AuthCreds:        h.authCreds,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: "failed to initialize table client", Detail: err.Error()},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	err = db.ExecQuery(ctx, prepareAlterUserQuery(entity.GetEntityPath(), options...), tbl.NonIdempotent)
+	if err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: "failed to execute query ALTER USER ...", Detail: err.Error()},
+		}
+	}
+
+	return h.Read(ctx, d, meta)
+}
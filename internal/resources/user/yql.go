@@ -0,0 +1,51 @@
+package user
+
+import (
+	"strings"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+)
+
+const selectUserQuery = "SELECT COALESCE(IsEnabled, true) AS IsEnabled FROM `.sys/auth_users` WHERE Sid = $sid"
+
+func passwordOption(password string) string {
+	return "PASSWORD '" + helpers.EscapeYQLString(password) + "'"
+}
+
+func hashOption(hash string) string {
+	return "HASH '" + helpers.EscapeYQLString(hash) + "'"
+}
+
+const noPasswordOption = "PASSWORD NULL"
+
+func loginOption(login bool) string {
+	if login {
+		return "LOGIN"
+	}
+	return "NOLOGIN"
+}
+
+func prepareCreateUserQuery(name string, options ...string) string {
+	buf := make([]byte, 0, 128)
+	buf = append(buf, "CREATE USER `"...)
+	buf = append(buf, helpers.EscapeYQLIdentifier(name)...)
+	buf = append(buf, '`')
+	if len(options) > 0 {
+		buf = append(buf, ' ')
+		buf = append(buf, strings.Join(options, " ")...)
+	}
+	return string(buf)
+}
+
+func prepareAlterUserQuery(name string, options ...string) string {
+	buf := make([]byte, 0, 128)
+	buf = append(buf, "ALTER USER `"...)
+	buf = append(buf, helpers.EscapeYQLIdentifier(name)...)
+	buf = append(buf, "` WITH "...)
+	buf = append(buf, strings.Join(options, " ")...)
+	return string(buf)
+}
+
+func prepareDropUserQuery(name string) string {
+	return "DROP USER `" + helpers.EscapeYQLIdentifier(name) + "`"
+}
@@ -0,0 +1,56 @@
+package user
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrepareCreateUserQuery(t *testing.T) {
+	testData := []struct {
+		testName string
+		name     string
+		options  []string
+		expected string
+	}{
+		{
+			testName: "without options",
+			name:     "alice",
+			expected: "CREATE USER `alice`",
+		},
+		{
+			testName: "password and login",
+			name:     "alice",
+			options:  []string{passwordOption("p@ss'word"), loginOption(true)},
+			expected: "CREATE USER `alice` PASSWORD 'p@ss\\'word' LOGIN",
+		},
+		{
+			testName: "hash and nologin",
+			name:     "bob",
+			options:  []string{hashOption(`{"hash":"aGFzaA==","salt":"c2FsdA==","type":"argon2id"}`), loginOption(false)},
+			expected: "CREATE USER `bob` HASH '{\"hash\":\"aGFzaA==\",\"salt\":\"c2FsdA==\",\"type\":\"argon2id\"}' NOLOGIN",
+		},
+		{
+			testName: "escaped name",
+			name:     "we`ird",
+			options:  []string{loginOption(true)},
+			expected: "CREATE USER `we``ird` LOGIN",
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.testName, func(t *testing.T) {
+			assert.Equal(t, v.expected, prepareCreateUserQuery(v.name, v.options...))
+		})
+	}
+}
+
+func TestPrepareAlterUserQuery(t *testing.T) {
+	assert.Equal(t, "ALTER USER `alice` WITH PASSWORD NULL", prepareAlterUserQuery("alice", noPasswordOption))
+	assert.Equal(t, "ALTER USER `alice` WITH PASSWORD 'new' NOLOGIN", prepareAlterUserQuery("alice", passwordOption("new"), loginOption(false)))
+	assert.Equal(t, `ALTER USER `+"`alice`"+` WITH PASSWORD 'a\\\'b\\'`, prepareAlterUserQuery("alice", passwordOption(`a\'b\`)))
+}
+
+func TestPrepareDropUserQuery(t *testing.T) {
+	assert.Equal(t, "DROP USER `alice`", prepareDropUserQuery("alice"))
+}
@@ -0,0 +1,50 @@
+package view
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+func (h *handler) Read(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	entity, err := helpers.ParseYDBEntityID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	db, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: entity.PrepareFullYDBEndpoint(),
+		AuthCreds:        h.authCreds,
+	})
+	if err != nil {
+		return diag.Diagnostics{
+			{Severity: diag.Error, Summary: "failed to initialize table client", Detail: err.Error()},
+		}
+	}
+	defer func() {
+		_ = db.Close(ctx)
+	}()
+
+	queryText, err := describeView(ctx, db, entity.GetFullEntityPath())
+	if err != nil {
+		if helpers.IsSchemeErrorStatus(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("failed to describe view %q: %s", entity.GetFullEntityPath(), err)
+	}
+
+	_ = d.Set("connection_string", entity.PrepareFullYDBEndpoint())
+	_ = d.Set("path", entity.GetEntityPath())
+	_ = d.Set("full_path", entity.GetFullEntityPath())
+	// Keep the configured spelling of the query unless it really differs from the stored one.
+	if normalizeQuery(d.Get("query").(string)) != normalizeQuery(queryText) {
+		_ = d.Set("query", queryText)
+	}
+
+	return nil
+}
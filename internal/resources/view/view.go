@@ -0,0 +1,45 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/ydb-platform/ydb-go-genproto/draft/Ydb_View_V1"
+	"github.com/ydb-platform/ydb-go-genproto/draft/protos/Ydb_View"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	ydb "github.com/ydb-platform/ydb-go-sdk/v3"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/retry"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
+)
+
+// SuppressQueryDiff ignores query differences that YDB does not preserve (surrounding whitespace
+// and trailing semicolons).
+func SuppressQueryDiff(_, oldValue, newValue string, _ *schema.ResourceData) bool {
+	return normalizeQuery(oldValue) == normalizeQuery(newValue)
+}
+
+// describeView returns the stored query text of the view at fullPath. The SDK scheme client
+// does not describe views, so the request goes to the view service directly.
+func describeView(ctx context.Context, db *tbl.Driver, fullPath string) (string, error) {
+	client := Ydb_View_V1.NewViewServiceClient(ydb.GRPCConn(db.Driver))
+	var queryText string
+	err := db.Retry(ctx, "describe view", tbl.Idempotent, func(ctx context.Context) error {
+		resp, err := client.DescribeView(ctx, &Ydb_View.DescribeViewRequest{Path: fullPath})
+		if err != nil {
+			return fmt.Errorf("describe_view problem: %w", err)
+		}
+		op := resp.GetOperation()
+		if op.GetStatus() != Ydb.StatusIds_SUCCESS {
+			return fmt.Errorf("describe view operation code not success: %w", &retry.StatusError{Status: op.GetStatus(), Issues: op.GetIssues()})
+		}
+		result := &Ydb_View.DescribeViewResult{}
+		if err := op.GetResult().UnmarshalTo(result); err != nil {
+			return fmt.Errorf("unmarshal_to problem: %w", err)
+		}
+		queryText = result.GetQueryText()
+		return nil
+	})
+	return queryText, err
+}
@@ -0,0 +1,41 @@
+package view
+
+import (
+	"strings"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+)
+
+func prepareCreateViewQuery(fullPath, query string, securityInvoker bool) string {
+	return prepareViewQuery("CREATE VIEW `", fullPath, query, securityInvoker)
+}
+
+// prepareReplaceViewQuery swaps the query of an existing view atomically, YDB has no ALTER VIEW.
+func prepareReplaceViewQuery(fullPath, query string, securityInvoker bool) string {
+	return prepareViewQuery("CREATE OR REPLACE VIEW `", fullPath, query, securityInvoker)
+}
+
+func prepareViewQuery(prefix, fullPath, query string, securityInvoker bool) string {
+	var b strings.Builder
+	b.WriteString(prefix)
+	b.WriteString(helpers.EscapeYQLIdentifier(fullPath))
+	b.WriteString("` WITH (security_invoker = ")
+	if securityInvoker {
+		b.WriteString("TRUE")
+	} else {
+		b.WriteString("FALSE")
+	}
+	b.WriteString(") AS ")
+	b.WriteString(normalizeQuery(query))
+	return b.String()
+}
+
+func prepareDropViewQuery(fullPath string) string {
+	return "DROP VIEW `" + helpers.EscapeYQLIdentifier(fullPath) + "`"
+}
+
+// normalizeQuery strips surrounding whitespace and trailing semicolons, which YDB does not keep
+// in the stored query text.
+func normalizeQuery(query string) string {
+	return strings.TrimSpace(strings.TrimRight(strings.TrimSpace(query), ";"))
+}
@@ -0,0 +1,42 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrepareViewQueries(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{
+			name: "create",
+			got:  prepareCreateViewQuery("/local/reports/daily", "SELECT * FROM `orders`", true),
+			want: "CREATE VIEW `/local/reports/daily` WITH (security_invoker = TRUE) AS SELECT * FROM `orders`",
+		},
+		{
+			name: "replace strips trailing semicolon",
+			got:  prepareReplaceViewQuery("/local/v", "\n  SELECT 1;\n", false),
+			want: "CREATE OR REPLACE VIEW `/local/v` WITH (security_invoker = FALSE) AS SELECT 1",
+		},
+		{
+			name: "drop",
+			got:  prepareDropViewQuery("/local/reports/daily"),
+			want: "DROP VIEW `/local/reports/daily`",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.got)
+		})
+	}
+}
+
+func TestSuppressQueryDiff(t *testing.T) {
+	assert.True(t, SuppressQueryDiff("query", "SELECT 1", "  SELECT 1;\n", nil))
+	assert.False(t, SuppressQueryDiff("query", "SELECT 1", "SELECT 2", nil))
+}
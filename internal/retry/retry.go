@@ -0,0 +1,281 @@
+// Package retry retries YDB operations that fail with transient errors.
+//
+// Besides overloaded or unavailable nodes, YDB rejects concurrent schema changes of one path with
+// a "path is busy" conflict, which is routine when Terraform alters one table from several
+// resources (e.g. indexes) in parallel. Such errors are retried with exponential backoff; all
+// other errors are returned immediately.
+//
+// An UNAVAILABLE status or a broken connection does not tell whether the operation ran, so
+// operations that must not run twice (CREATE, BACKUP, ALTER SEQUENCE ... RESTART, ...) are marked
+// NonIdempotent and only retried on errors that guarantee they did not execute.
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Issue"
+	ydb "github.com/ydb-platform/ydb-go-sdk/v3"
+	grpcCodes "google.golang.org/grpc/codes"
+)
+
+// Idempotency tells Do whether an operation leaves the same state when it runs twice.
+type Idempotency bool
+
+const (
+	// Idempotent operations are retried on every transient error.
+	Idempotent Idempotency = true
+	// NonIdempotent operations are only retried on errors that guarantee they did not execute.
+	NonIdempotent Idempotency = false
+)
+
+// Policy configures retries of a single operation.
+type Policy struct {
+	// MaxAttempts is the total number of attempts, including the first one. Values below 1 mean 1.
+	MaxAttempts int
+	// BaseBackoff is the delay before the second attempt. It doubles with every attempt up to MaxBackoff.
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+	// Jitter is the fraction (0..1) of every delay that is randomized.
+	Jitter float64
+}
+
+// DefaultPolicy is used when the provider has no retry block.
+func DefaultPolicy() Policy {
+	return Policy{
+		MaxAttempts: 5,
+		BaseBackoff: time.Second,
+		MaxBackoff:  30 * time.Second,
+		Jitter:      0.2,
+	}
+}
+
+// Validate reports invalid policy settings.
+func (p Policy) Validate() error {
+	switch {
+	case p.MaxAttempts < 1:
+		return fmt.Errorf("max_attempts must be at least 1, got %d", p.MaxAttempts)
+	case p.BaseBackoff < 0:
+		return fmt.Errorf("base_backoff must not be negative, got %s", p.BaseBackoff)
+	case p.MaxBackoff < p.BaseBackoff:
+		return fmt.Errorf("max_backoff (%s) must not be less than base_backoff (%s)", p.MaxBackoff, p.BaseBackoff)
+	case p.Jitter < 0 || p.Jitter > 1:
+		return fmt.Errorf("jitter must be between 0 and 1, got %v", p.Jitter)
+	}
+	return nil
+}
+
+// Backoff returns the delay before attempt number attempt+1 without jitter.
+func (p Policy) Backoff(attempt int) time.Duration {
+	d := p.BaseBackoff
+	for i := 1; i < attempt && d < p.MaxBackoff; i++ {
+		d *= 2
+	}
+	if d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	return d
+}
+
+func (p Policy) delay(attempt int) time.Duration {
+	d := p.Backoff(attempt)
+	if p.Jitter > 0 && d > 0 {
+		spread := float64(d) * p.Jitter
+		d = time.Duration(float64(d) - spread + rand.Float64()*spread) //nolint:gosec // jitter does not need crypto/rand
+	}
+	return d
+}
+
+// StatusError is a non-success YDB operation status returned by raw gRPC calls (e.g. KV volume
+// RPCs), where the SDK does not convert statuses into errors.
+type StatusError struct {
+	Status Ydb.StatusIds_StatusCode
+	Issues []*Ydb_Issue.IssueMessage
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("%s, %v", e.Status, e.Issues)
+}
+
+var retryableStatuses = []Ydb.StatusIds_StatusCode{
+	Ydb.StatusIds_OVERLOADED,
+	Ydb.StatusIds_UNAVAILABLE,
+	Ydb.StatusIds_SESSION_BUSY,
+	Ydb.StatusIds_BAD_SESSION,
+	Ydb.StatusIds_SESSION_EXPIRED,
+}
+
+// notExecutedStatuses are rejections received before the operation started.
+var notExecutedStatuses = []Ydb.StatusIds_StatusCode{
+	Ydb.StatusIds_OVERLOADED,
+	Ydb.StatusIds_SESSION_BUSY,
+	Ydb.StatusIds_BAD_SESSION,
+}
+
+var retryableTransportCodes = []grpcCodes.Code{
+	grpcCodes.Unavailable,
+	grpcCodes.ResourceExhausted,
+	grpcCodes.Aborted,
+}
+
+// busyMarkers are lower-case fragments of scheme shard messages rejecting a schema change because
+// another operation on the same path is still running.
+var busyMarkers = []string{
+	"path is busy",
+	"under operation",
+	"multiple modifications",
+}
+
+// busyIssueMarkers are generic fragments of the same rejections. Other errors may contain them as
+// well, so they are only looked for in the issues of busyIssueStatuses.
+var busyIssueMarkers = []string{
+	"in progress",
+	"another operation",
+}
+
+var busyIssueStatuses = []Ydb.StatusIds_StatusCode{
+	Ydb.StatusIds_OVERLOADED,
+	Ydb.StatusIds_GENERIC_ERROR,
+}
+
+// IsRetryable classifies err of an idempotent operation as transient (true) or fatal (false).
+func IsRetryable(err error) bool {
+	if isFinal(err) {
+		return false
+	}
+
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		for _, s := range retryableStatuses {
+			if statusErr.Status == s {
+				return true
+			}
+		}
+		return isBusy(err)
+	}
+	if ydb.IsOperationError(err, retryableStatuses...) {
+		return true
+	}
+	if ydb.IsOperationError(err) {
+		return isBusy(err)
+	}
+	if ydb.IsTransportError(err, retryableTransportCodes...) {
+		return true
+	}
+	return isBusy(err)
+}
+
+// IsRetryableNonIdempotent reports whether err guarantees that a non-idempotent operation did not
+// execute, so that it can be retried: an OVERLOADED, SESSION_BUSY or BAD_SESSION status or a
+// scheme shard "path is busy" conflict.
+func IsRetryableNonIdempotent(err error) bool {
+	if isFinal(err) {
+		return false
+	}
+
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		for _, s := range notExecutedStatuses {
+			if statusErr.Status == s {
+				return true
+			}
+		}
+		return isBusy(err)
+	}
+	if ydb.IsOperationError(err, notExecutedStatuses...) {
+		return true
+	}
+	return isBusy(err)
+}
+
+func isFinal(err error) bool {
+	return err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+func isBusy(err error) bool {
+	if containsAny(err.Error(), busyMarkers) {
+		return true
+	}
+	return hasBusyIssue(statusIssues(err))
+}
+
+// statusIssues returns the issues of a StatusError or a YDB operation error with one of the
+// busyIssueStatuses.
+func statusIssues(err error) []*Ydb_Issue.IssueMessage {
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		for _, s := range busyIssueStatuses {
+			if statusErr.Status == s {
+				return statusErr.Issues
+			}
+		}
+		return nil
+	}
+	var opErr interface {
+		Issues() []*Ydb_Issue.IssueMessage
+	}
+	if ydb.IsOperationError(err, busyIssueStatuses...) && errors.As(err, &opErr) {
+		return opErr.Issues()
+	}
+	return nil
+}
+
+func hasBusyIssue(issues []*Ydb_Issue.IssueMessage) bool {
+	for _, issue := range issues {
+		if containsAny(issue.GetMessage(), busyIssueMarkers) || hasBusyIssue(issue.GetIssues()) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAny(msg string, markers []string) bool {
+	msg = strings.ToLower(msg)
+	for _, m := range markers {
+		if strings.Contains(msg, m) {
+			return true
+		}
+	}
+	return false
+}
+
+// Do calls f until it succeeds, fails with an error that is not retryable for the given
+// idempotency, the policy runs out of attempts or ctx is done. op names the operation in logs.
+func Do(ctx context.Context, p Policy, op string, idempotency Idempotency, f func(ctx context.Context) error) error {
+	retryable := IsRetryable
+	if idempotency == NonIdempotent {
+		retryable = IsRetryableNonIdempotent
+	}
+	attempts := p.MaxAttempts
+	if attempts < 1 {
+		attempts = 1
+	}
+	for attempt := 1; ; attempt++ {
+		err := f(ctx)
+		if err == nil {
+			return nil
+		}
+		if attempt >= attempts || !retryable(err) {
+			if attempt > 1 {
+				return fmt.Errorf("%s failed after %d attempts: %w", op, attempt, err)
+			}
+			return err
+		}
+
+		delay := p.delay(attempt)
+		log.Printf("[WARN] %s: attempt %d/%d failed with retryable error, retrying in %s: %v", op, attempt, attempts, delay, err)
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("%s: %w (last error: %v)", op, ctx.Err(), err)
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb"
+	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Issue"
+	grpcCodes "google.golang.org/grpc/codes"
+	grpcStatus "google.golang.org/grpc/status"
+)
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"overloaded status", &StatusError{Status: Ydb.StatusIds_OVERLOADED}, true},
+		{"wrapped unavailable status", fmt.Errorf("create: %w", &StatusError{Status: Ydb.StatusIds_UNAVAILABLE}), true},
+		{"scheme error", &StatusError{Status: Ydb.StatusIds_SCHEME_ERROR}, false},
+		{
+			name: "path is busy",
+			err: &StatusError{
+				Status: Ydb.StatusIds_PRECONDITION_FAILED,
+				Issues: []*Ydb_Issue.IssueMessage{{Message: "Check failed: path: '/local/t', error: path is busy"}},
+			},
+			want: true,
+		},
+		{"path under operation", errors.New("error: path is under operation (id: 1, state: EPathStateAlter)"), true},
+		{
+			name: "another operation in generic error issues",
+			err: fmt.Errorf("alter: %w", &StatusError{
+				Status: Ydb.StatusIds_GENERIC_ERROR,
+				Issues: []*Ydb_Issue.IssueMessage{{
+					Message: "Execution failed",
+					Issues:  []*Ydb_Issue.IssueMessage{{Message: "Another operation is in progress"}},
+				}},
+			}),
+			want: true,
+		},
+		{
+			name: "in progress in scheme error issues",
+			err: &StatusError{
+				Status: Ydb.StatusIds_SCHEME_ERROR,
+				Issues: []*Ydb_Issue.IssueMessage{{Message: "Column drop is in progress"}},
+			},
+			want: false,
+		},
+		{"in progress in plain error", errors.New("read table: sync in progress: column not found"), false},
+		{"transport unavailable", grpcStatus.Error(grpcCodes.Unavailable, "connection refused"), true},
+		{"transport permission denied", grpcStatus.Error(grpcCodes.PermissionDenied, "denied"), false},
+		{"context canceled", fmt.Errorf("op: %w", context.Canceled), false},
+		{"deadline exceeded", context.DeadlineExceeded, false},
+		{"plain error", errors.New("column not found"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsRetryable(tt.err))
+		})
+	}
+}
+
+func TestIsRetryableNonIdempotent(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"overloaded status", &StatusError{Status: Ydb.StatusIds_OVERLOADED}, true},
+		{"bad session status", fmt.Errorf("create: %w", &StatusError{Status: Ydb.StatusIds_BAD_SESSION}), true},
+		{"session busy status", &StatusError{Status: Ydb.StatusIds_SESSION_BUSY}, true},
+		{"unavailable status", &StatusError{Status: Ydb.StatusIds_UNAVAILABLE}, false},
+		{"session expired status", &StatusError{Status: Ydb.StatusIds_SESSION_EXPIRED}, false},
+		{
+			name: "path is busy",
+			err: &StatusError{
+				Status: Ydb.StatusIds_PRECONDITION_FAILED,
+				Issues: []*Ydb_Issue.IssueMessage{{Message: "Check failed: path: '/local/t', error: path is busy"}},
+			},
+			want: true,
+		},
+		{
+			name: "another operation in generic error issues",
+			err: &StatusError{
+				Status: Ydb.StatusIds_GENERIC_ERROR,
+				Issues: []*Ydb_Issue.IssueMessage{{Message: "Another operation is in progress"}},
+			},
+			want: true,
+		},
+		{"transport unavailable", grpcStatus.Error(grpcCodes.Unavailable, "connection refused"), false},
+		{"transport resource exhausted", grpcStatus.Error(grpcCodes.ResourceExhausted, "limit"), false},
+		{"context canceled", fmt.Errorf("op: %w", context.Canceled), false},
+		{"plain error", errors.New("already exists"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsRetryableNonIdempotent(tt.err))
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	p := Policy{MaxAttempts: 10, BaseBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	assert.Equal(t, 100*time.Millisecond, p.Backoff(1))
+	assert.Equal(t, 200*time.Millisecond, p.Backoff(2))
+	assert.Equal(t, 800*time.Millisecond, p.Backoff(4))
+	assert.Equal(t, time.Second, p.Backoff(5))
+	assert.Equal(t, time.Second, p.Backoff(50))
+
+	p.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		d := p.delay(2)
+		assert.GreaterOrEqual(t, d, 100*time.Millisecond)
+		assert.LessOrEqual(t, d, 200*time.Millisecond)
+	}
+}
+
+func TestPolicyValidate(t *testing.T) {
+	assert.NoError(t, DefaultPolicy().Validate())
+	assert.Error(t, Policy{MaxAttempts: 0}.Validate())
+	assert.Error(t, Policy{MaxAttempts: 1, BaseBackoff: time.Second, MaxBackoff: time.Millisecond}.Validate())
+	assert.Error(t, Policy{MaxAttempts: 1, Jitter: 2}.Validate())
+}
+
+func TestDo(t *testing.T) {
+	p := Policy{MaxAttempts: 3, BaseBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+	busy := &StatusError{Status: Ydb.StatusIds_OVERLOADED}
+
+	t.Run("succeeds after transient errors", func(t *testing.T) {
+		calls := 0
+		err := Do(context.Background(), p, "op", Idempotent, func(context.Context) error {
+			calls++
+			if calls < 3 {
+				return busy
+			}
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("gives up after max attempts", func(t *testing.T) {
+		calls := 0
+		err := Do(context.Background(), p, "op", Idempotent, func(context.Context) error {
+			calls++
+			return busy
+		})
+		assert.ErrorIs(t, err, busy)
+		assert.Contains(t, err.Error(), "after 3 attempts")
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("fatal error is not retried", func(t *testing.T) {
+		fatal := errors.New("syntax error")
+		calls := 0
+		err := Do(context.Background(), p, "op", Idempotent, func(context.Context) error {
+			calls++
+			return fatal
+		})
+		assert.Equal(t, fatal, err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("non-idempotent operation is not retried when it may have run", func(t *testing.T) {
+		unavailable := &StatusError{Status: Ydb.StatusIds_UNAVAILABLE}
+		calls := 0
+		err := Do(context.Background(), p, "op", NonIdempotent, func(context.Context) error {
+			calls++
+			return unavailable
+		})
+		assert.Equal(t, unavailable, err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("non-idempotent operation is retried when it did not run", func(t *testing.T) {
+		calls := 0
+		err := Do(context.Background(), p, "op", NonIdempotent, func(context.Context) error {
+			calls++
+			if calls < 2 {
+				return busy
+			}
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("zero policy makes a single attempt", func(t *testing.T) {
+		calls := 0
+		err := Do(context.Background(), Policy{}, "op", Idempotent, func(context.Context) error {
+			calls++
+			return busy
+		})
+		assert.Equal(t, busy, err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("stops when context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		slow := Policy{MaxAttempts: 5, BaseBackoff: time.Hour, MaxBackoff: time.Hour}
+		calls := 0
+		err := Do(ctx, slow, "op", Idempotent, func(context.Context) error {
+			calls++
+			cancel()
+			return busy
+		})
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 1, calls)
+	})
+}
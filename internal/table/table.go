@@ -2,9 +2,15 @@ package table
 
 import (
 	"context"
+	"crypto/tls"
 
 	ydb "github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/credentials"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table"
+	"github.com/ydb-platform/ydb-go-sdk/v3/table/options"
 
+	"github.com/ydb-platform/terraform-provider-ydb/internal/pool"
+	"github.com/ydb-platform/terraform-provider-ydb/internal/retry"
 	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
 )
 
@@ -13,18 +19,108 @@ type ClientParams struct {
 	AuthCreds        auth.YdbCredentials
 }
 
-func CreateDBConnection(ctx context.Context, params ClientParams) (*ydb.Driver, error) {
-	var opts []ydb.Option
-	switch {
-	case params.AuthCreds.Token != "":
-		opts = append(opts, ydb.WithAccessTokenCredentials(params.AuthCreds.Token))
-	case params.AuthCreds.User != "":
-		opts = append(opts, ydb.WithStaticCredentials(params.AuthCreds.User, params.AuthCreds.Password))
-	}
+// Driver is a YDB driver borrowed from the provider connection pool. Close returns it to the pool
+// instead of closing the underlying connection.
+type Driver struct {
+	*ydb.Driver
+	release     func()
+	retryPolicy retry.Policy
+}
+
+// Close releases the driver. It must be called exactly once.
+func (d *Driver) Close(_ context.Context) error {
+	d.release()
+	return nil
+}
+
+// Idempotency tells the retrying wrappers whether a statement may run twice. See retry.Idempotency.
+type Idempotency = retry.Idempotency
+
+const (
+	// Idempotent statements leave the same state when they run twice, e.g. ALTER ... SET or
+	// CREATE OR REPLACE.
+	Idempotent = retry.Idempotent
+	// NonIdempotent statements fail or change the state again when they run twice, e.g. CREATE,
+	// DROP, BACKUP or ALTER SEQUENCE ... RESTART.
+	NonIdempotent = retry.NonIdempotent
+)
+
+// Retry runs f with the provider retry policy. op names the operation in logs.
+func (d *Driver) Retry(ctx context.Context, op string, idempotency Idempotency, f func(ctx context.Context) error) error {
+	return retry.Do(ctx, d.retryPolicy, op, idempotency, f)
+}
+
+// ExecuteSchemeQuery executes a schema query in a table service session, retrying transient
+// errors and "path is busy" conflicts.
+func (d *Driver) ExecuteSchemeQuery(ctx context.Context, query string, idempotency Idempotency) error {
+	return d.Retry(ctx, "execute scheme query", idempotency, func(ctx context.Context) error {
+		return d.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+			return s.ExecuteSchemeQuery(ctx, query)
+		})
+	})
+}
+
+// AlterTable alters the table at path in a table service session, retrying transient errors and
+// "path is busy" conflicts. It is used for changes that have no YQL syntax.
+func (d *Driver) AlterTable(ctx context.Context, path string, idempotency Idempotency, opts ...options.AlterTableOption) error {
+	return d.Retry(ctx, "alter table", idempotency, func(ctx context.Context) error {
+		return d.Table().Do(ctx, func(ctx context.Context, s table.Session) error {
+			return s.AlterTable(ctx, path, opts...)
+		})
+	})
+}
+
+// DescribeTable describes the table at path in a table service session.
+func (d *Driver) DescribeTable(ctx context.Context, path string, opts ...options.DescribeTableOption) (options.Description, error) {
+	var description options.Description
+	err := d.Table().Do(ctx, func(ctx context.Context, s table.Session) (err error) {
+		description, err = s.DescribeTable(ctx, path, opts...)
+		return err
+	})
+	return description, err
+}
 
-	db, err := ydb.Open(ctx, params.DatabaseEndpoint, opts...)
+// ExecQuery executes a query with the query service, retrying transient errors and "path is
+// busy" conflicts.
+func (d *Driver) ExecQuery(ctx context.Context, query string, idempotency Idempotency) error {
+	return d.Retry(ctx, "execute query", idempotency, func(ctx context.Context) error {
+		return d.Query().Exec(ctx, query)
+	})
+}
+
+type driverKey struct {
+	databaseEndpoint string
+	token            string
+	user             string
+	password         string
+	credentials      credentials.Credentials
+	tlsConfig        *tls.Config
+}
+
+// CreateDBConnection returns a driver for params, shared with other operations using the same
+// endpoint, database and credentials.
+func CreateDBConnection(ctx context.Context, params ClientParams) (*Driver, error) {
+	key := driverKey{
+		databaseEndpoint: params.DatabaseEndpoint,
+		token:            params.AuthCreds.Token,
+		user:             params.AuthCreds.User,
+		password:         params.AuthCreds.Password,
+		credentials:      params.AuthCreds.Credentials,
+		tlsConfig:        params.AuthCreds.TLSConfig,
+	}
+	conn, release, err := pool.Default.Acquire(ctx, key, func(ctx context.Context) (interface{}, func() error, error) {
+		db, err := ydb.Open(ctx, params.DatabaseEndpoint, params.AuthCreds.Options()...)
+		if err != nil {
+			return nil, nil, err
+		}
+		return db, func() error { return db.Close(context.Background()) }, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	return db, nil
+	return &Driver{
+		Driver:      conn.(*ydb.Driver),
+		release:     release,
+		retryPolicy: params.AuthCreds.RetryPolicy,
+	}, nil
 }
@@ -46,8 +46,17 @@ func accTestConfigPrefix(conn string) string {
 }
 
 func accProviderBlock() string {
+	return accProviderBlockWithConnectionString("")
+}
+
+// accProviderBlockWithConnectionString is the ydb provider block with a provider-level
+// connection_string (omitted when conn is empty).
+func accProviderBlockWithConnectionString(conn string) string {
 	var b strings.Builder
 	b.WriteString(`provider "ydb" {`)
+	if conn != "" {
+		fmt.Fprintf(&b, "\n  connection_string = %q", conn)
+	}
 	if v := os.Getenv("YDB_ACC_TOKEN"); v != "" {
 		fmt.Fprintf(&b, "\n  token = %q", v)
 	}
@@ -0,0 +1,63 @@
+package terraform
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/asyncreplication"
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
+)
+
+func ydbAsyncReplicationResource() *schema.Resource {
+	return &schema.Resource{
+		Schema:        asyncreplication.ResourceSchema(),
+		SchemaVersion: 0,
+		CreateContext: resourceYDBAsyncReplicationCreate,
+		ReadContext:   resourceYDBAsyncReplicationRead,
+		UpdateContext: resourceYDBAsyncReplicationUpdate,
+		DeleteContext: resourceYDBAsyncReplicationDelete,
+		CustomizeDiff: customdiff.All(
+			defaultConnectionStringDiff(),
+			asyncreplication.ResourceCustomizeDiff,
+		),
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithDefaultConnectionString(nil),
+		},
+		Timeouts: defaultTimeouts(),
+	}
+}
+
+func resourceYDBAsyncReplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return asyncreplication.ResourceCreateFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBAsyncReplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return asyncreplication.ResourceReadFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBAsyncReplicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return asyncreplication.ResourceUpdateFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBAsyncReplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return asyncreplication.ResourceDeleteFunc(cb)(ctx, d, meta)
+}
@@ -0,0 +1,114 @@
+package terraform
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/backupcollection"
+)
+
+func ydbBackupCollectionResource() *schema.Resource {
+	return &schema.Resource{
+		Schema:        backupcollection.ResourceSchema(),
+		SchemaVersion: 0,
+		CreateContext: resourceYDBBackupCollectionCreate,
+		ReadContext:   resourceYDBBackupCollectionRead,
+		UpdateContext: resourceYDBBackupCollectionUpdate,
+		DeleteContext: resourceYDBBackupCollectionDelete,
+		CustomizeDiff: defaultConnectionStringDiff(),
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithDefaultConnectionString(nil),
+		},
+		Timeouts: defaultTimeouts(),
+	}
+}
+
+func ydbBackupResource() *schema.Resource {
+	return &schema.Resource{
+		Schema:        backupcollection.BackupResourceSchema(),
+		SchemaVersion: 0,
+		CreateContext: resourceYDBBackupCreate,
+		ReadContext:   resourceYDBBackupRead,
+		DeleteContext: resourceYDBBackupDelete,
+		CustomizeDiff: defaultConnectionStringDiff(),
+		Timeouts:      defaultTimeouts(),
+	}
+}
+
+func ydbBackupHistoryDataSource() *schema.Resource {
+	return &schema.Resource{
+		Schema:      backupcollection.HistoryDataSourceSchema(),
+		ReadContext: dataSourceYDBBackupHistoryRead,
+		Timeouts:    defaultTimeouts(),
+	}
+}
+
+func resourceYDBBackupCollectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return backupcollection.ResourceCreateFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBBackupCollectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return backupcollection.ResourceReadFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBBackupCollectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return backupcollection.ResourceUpdateFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBBackupCollectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return backupcollection.ResourceDeleteFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBBackupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return backupcollection.BackupResourceCreateFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBBackupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return backupcollection.BackupResourceReadFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBBackupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return backupcollection.BackupResourceDeleteFunc(cb)(ctx, d, meta)
+}
+
+func dataSourceYDBBackupHistoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	if err := setDefaultConnectionString(d, cfg); err != nil {
+		return diag.FromErr(err)
+	}
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return backupcollection.DataSourceHistoryReadFunc(cb)(ctx, d, meta)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
@@ -66,8 +67,12 @@ func ydbTableChangeFeedResource() *schema.Resource {
 		ReadContext:   resourceYDBTableChangefeedRead,
 		UpdateContext: resourceYDBTableChangefeedUpdate,
 		DeleteContext: resourceYDBTableChangefeedDelete,
+		CustomizeDiff: customdiff.All(
+			defaultConnectionStringDiff("table_id"),
+			changefeed.ResourceCustomizeDiff,
+		),
 		Importer: &schema.ResourceImporter{
-			StateContext: changefeed.ResourceImportFunc,
+			StateContext: importStateWithDefaultConnectionString(changefeed.ResourceImportFunc),
 		},
 		Timeouts: defaultTimeouts(),
 	}
@@ -0,0 +1,119 @@
+package terraform
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/attributes"
+)
+
+const envConnectionString = "YDB_CONNECTION_STRING"
+
+var errNoConnectionString = fmt.Errorf(
+	"%q is not set: configure it on the resource, on the provider or via the %s environment variable",
+	attributes.ConnectionString, envConnectionString,
+)
+
+// isConfigured reports whether key is set (possibly to a not yet known value) in the resource configuration.
+func isConfigured(d *schema.ResourceDiff, key string) bool {
+	raw := d.GetRawConfig()
+	if raw.IsNull() || !raw.IsKnown() {
+		return false
+	}
+	return !raw.GetAttr(key).IsNull()
+}
+
+// sameConnectionString compares two connection strings by endpoint, database and protocol, so
+// that equivalent spellings of the same database do not force resource replacement.
+func sameConnectionString(a, b string) bool {
+	if a == b {
+		return true
+	}
+	aEndpoint, aDatabase, aTLS, err := helpers.ParseYDBDatabaseEndpoint(a)
+	if err != nil {
+		return false
+	}
+	bEndpoint, bDatabase, bTLS, err := helpers.ParseYDBDatabaseEndpoint(b)
+	if err != nil {
+		return false
+	}
+	return aEndpoint == bEndpoint && strings.TrimSuffix(aDatabase, "/") == strings.TrimSuffix(bDatabase, "/") && aTLS == bTLS
+}
+
+// defaultConnectionStringDiff plans the provider-level connection string for resources that omit
+// connection_string. Since connection_string is ForceNew, a changed provider default replaces the
+// resources that inherited it. Keys in exclusive (e.g. table_id) carry their own connection string,
+// so the default is not applied when any of them is configured.
+func defaultConnectionStringDiff(exclusive ...string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if isConfigured(d, attributes.ConnectionString) {
+			return nil
+		}
+		for _, k := range exclusive {
+			if isConfigured(d, k) {
+				return nil
+			}
+		}
+
+		current := d.Get(attributes.ConnectionString).(string)
+		def := meta.(*Config).ConnectionString
+		if def == "" {
+			if current == "" && d.Id() == "" {
+				return errNoConnectionString
+			}
+			return nil
+		}
+		if sameConnectionString(current, def) {
+			return nil
+		}
+		return d.SetNew(attributes.ConnectionString, def)
+	}
+}
+
+// setDefaultConnectionString fills connection_string of a data source from the provider
+// configuration when it is omitted.
+func setDefaultConnectionString(d *schema.ResourceData, cfg *Config) error {
+	if d.Get(attributes.ConnectionString).(string) != "" {
+		return nil
+	}
+	if cfg.ConnectionString == "" {
+		return errNoConnectionString
+	}
+	return d.Set(attributes.ConnectionString, cfg.ConnectionString)
+}
+
+// resolveImportID turns a bare path relative to the database root into a full resource ID
+// using the provider-level connection string. Full IDs are returned unchanged.
+func resolveImportID(id string, cfg *Config) (string, error) {
+	if strings.Contains(id, "://") {
+		return id, nil
+	}
+	if cfg.ConnectionString == "" {
+		return "", fmt.Errorf("cannot import %q by relative path: %w", id, errNoConnectionString)
+	}
+	path := helpers.TrimPath(id)
+	if path == "" {
+		return "", fmt.Errorf("cannot import %q: got empty path", id)
+	}
+	return cfg.ConnectionString + "?path=" + path, nil
+}
+
+// importStateWithDefaultConnectionString wraps next (or passthrough import when next is nil) so
+// that `terraform import` accepts paths relative to the provider-level connection string.
+func importStateWithDefaultConnectionString(next schema.StateContextFunc) schema.StateContextFunc {
+	if next == nil {
+		next = schema.ImportStatePassthroughContext
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		id, err := resolveImportID(d.Id(), meta.(*Config))
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(id)
+		return next(ctx, d, meta)
+	}
+}
@@ -0,0 +1,85 @@
+package terraform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProviderInternalValidate(t *testing.T) {
+	require.NoError(t, Provider().InternalValidate())
+}
+
+func TestSameConnectionString(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want bool
+	}{
+		{"identical", "grpc://localhost:2136/?database=/local", "grpc://localhost:2136/?database=/local", true},
+		{"trailing slash in database", "grpc://localhost:2136/?database=/local/", "grpc://localhost:2136/?database=/local", true},
+		{"different protocol", "grpcs://localhost:2136/?database=/local", "grpc://localhost:2136/?database=/local", false},
+		{"different database", "grpc://localhost:2136/?database=/local", "grpc://localhost:2136/?database=/other", false},
+		{"different endpoint", "grpc://localhost:2136/?database=/local", "grpc://ydb:2136/?database=/local", false},
+		{"empty", "", "grpc://localhost:2136/?database=/local", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, sameConnectionString(tt.a, tt.b))
+		})
+	}
+}
+
+func TestResolveImportID(t *testing.T) {
+	cfg := &Config{ConnectionString: "grpc://localhost:2136/?database=/local"}
+	tests := []struct {
+		name    string
+		id      string
+		cfg     *Config
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "full id is unchanged",
+			id:   "grpcs://ydb:2135/?database=/db?path=a/b",
+			cfg:  cfg,
+			want: "grpcs://ydb:2135/?database=/db?path=a/b",
+		},
+		{
+			name: "relative path",
+			id:   "dir/table",
+			cfg:  cfg,
+			want: "grpc://localhost:2136/?database=/local?path=dir/table",
+		},
+		{
+			name: "relative path with slashes",
+			id:   "/dir/table/",
+			cfg:  cfg,
+			want: "grpc://localhost:2136/?database=/local?path=dir/table",
+		},
+		{
+			name:    "relative path without provider default",
+			id:      "dir/table",
+			cfg:     &Config{},
+			wantErr: true,
+		},
+		{
+			name:    "empty path",
+			id:      "/",
+			cfg:     cfg,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveImportID(tt.id, tt.cfg)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
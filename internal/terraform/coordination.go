@@ -18,8 +18,9 @@ func ydbCoordinationResource() *schema.Resource {
 		ReadContext:   resourceYDBCoordinationRead,
 		UpdateContext: resourceYDBCoordinationUpdate,
 		DeleteContext: resourceYDBCoordinationDelete,
+		CustomizeDiff: defaultConnectionStringDiff(),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateWithDefaultConnectionString(nil),
 		},
 		Timeouts: defaultTimeouts(),
 	}
@@ -75,6 +76,9 @@ func resourceYDBCoordinationDelete(ctx context.Context, d *schema.ResourceData,
 
 func dataSourceYDBCoordinationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*Config)
+	if err := setDefaultConnectionString(d, cfg); err != nil {
+		return diag.FromErr(err)
+	}
 	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
 		return cfg.AuthCreds, nil
 	}
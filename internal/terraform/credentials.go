@@ -0,0 +1,225 @@
+package terraform
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	ydbcredentials "github.com/ydb-platform/ydb-go-sdk/v3/credentials"
+
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
+)
+
+const defaultSubjectTokenType = "urn:ietf:params:oauth:token-type:access_token"
+
+var credentialsKinds = []string{
+	"credentials.0.token_file",
+	"credentials.0.oauth2_token_exchange",
+	"credentials.0.metadata",
+	"credentials.0.service_account_key",
+	"credentials.0.anonymous",
+}
+
+func credentialsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Description:   "Credentials used to authenticate in YDB. Exactly one kind of credentials must be set. Conflicts with `token` and `user`/`password`.",
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"token", "user", "password"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"token_file": {
+					Type:         schema.TypeString,
+					Description:  "Path to a file with an access token. The file is re-read on each request.",
+					Optional:     true,
+					ExactlyOneOf: credentialsKinds,
+				},
+				"oauth2_token_exchange": {
+					Type:         schema.TypeList,
+					Description:  "OAuth 2.0 token exchange (RFC 8693) credentials.",
+					Optional:     true,
+					MaxItems:     1,
+					ExactlyOneOf: credentialsKinds,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"token_endpoint": {
+								Type:        schema.TypeString,
+								Description: "Token exchange endpoint. Required unless set in `config_file`.",
+								Optional:    true,
+							},
+							"config_file": {
+								Type:        schema.TypeString,
+								Description: "Path to a token exchange config file in the YDB SDK format. Other attributes override its values.",
+								Optional:    true,
+							},
+							"grant_type": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"requested_token_type": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+							"audience": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"scope": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"resource": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"subject_token": {
+								Type:          schema.TypeString,
+								Optional:      true,
+								Sensitive:     true,
+								ConflictsWith: []string{"credentials.0.oauth2_token_exchange.0.subject_token_file"},
+							},
+							"subject_token_file": {
+								Type:        schema.TypeString,
+								Description: "Path to a file with the subject token, e.g. an OIDC token issued by CI. The file is re-read on every token exchange.",
+								Optional:    true,
+							},
+							"subject_token_type": {
+								Type:     schema.TypeString,
+								Optional: true,
+								Default:  defaultSubjectTokenType,
+							},
+						},
+					},
+				},
+				"metadata": {
+					Type:         schema.TypeList,
+					Description:  "Credentials of the compute instance service account obtained from the metadata service.",
+					Optional:     true,
+					MaxItems:     1,
+					ExactlyOneOf: credentialsKinds,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"url": {
+								Type:        schema.TypeString,
+								Description: "Metadata service token URL.",
+								Optional:    true,
+								Default:     auth.DefaultMetadataURL,
+							},
+						},
+					},
+				},
+				"service_account_key": {
+					Type:         schema.TypeList,
+					Description:  "Service account authorized key credentials.",
+					Optional:     true,
+					MaxItems:     1,
+					ExactlyOneOf: credentialsKinds,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"file": {
+								Type:        schema.TypeString,
+								Description: "Path to the service account key JSON file.",
+								Required:    true,
+							},
+							"iam_endpoint": {
+								Type:        schema.TypeString,
+								Description: "Endpoint exchanging the signed JWT for an IAM token.",
+								Optional:    true,
+								Default:     auth.DefaultIAMEndpoint,
+							},
+						},
+					},
+				},
+				"anonymous": {
+					Type:         schema.TypeBool,
+					Description:  "Connect without credentials.",
+					Optional:     true,
+					ExactlyOneOf: credentialsKinds,
+				},
+			},
+		},
+	}
+}
+
+// expandCredentials builds credentials from the provider `credentials` block. It returns nil
+// when the block is not set.
+func expandCredentials(raw []interface{}) (ydbcredentials.Credentials, error) {
+	if len(raw) == 0 || raw[0] == nil {
+		return nil, nil
+	}
+	m := raw[0].(map[string]interface{})
+
+	if path, ok := m["token_file"].(string); ok && path != "" {
+		return auth.NewTokenFileCredentials(path), nil
+	}
+	if v, ok := m["oauth2_token_exchange"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		return expandOauth2TokenExchange(v[0].(map[string]interface{}))
+	}
+	if v, ok := m["metadata"].([]interface{}); ok && len(v) > 0 {
+		url := ""
+		if v[0] != nil {
+			url = v[0].(map[string]interface{})["url"].(string)
+		}
+		return auth.NewMetadataCredentials(url), nil
+	}
+	if v, ok := m["service_account_key"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		sa := v[0].(map[string]interface{})
+		return auth.NewServiceAccountKeyFileCredentials(sa["file"].(string), sa["iam_endpoint"].(string))
+	}
+	if anonymous, ok := m["anonymous"].(bool); ok && anonymous {
+		return ydbcredentials.NewAnonymousCredentials(), nil
+	}
+	return nil, fmt.Errorf("credentials block must set exactly one kind of credentials")
+}
+
+func expandOauth2TokenExchange(m map[string]interface{}) (ydbcredentials.Credentials, error) {
+	var opts []ydbcredentials.Oauth2TokenExchangeCredentialsOption
+	if v := m["token_endpoint"].(string); v != "" {
+		opts = append(opts, ydbcredentials.WithTokenEndpoint(v))
+	}
+	if v := m["grant_type"].(string); v != "" {
+		opts = append(opts, ydbcredentials.WithGrantType(v))
+	}
+	if v := m["requested_token_type"].(string); v != "" {
+		opts = append(opts, ydbcredentials.WithRequestedTokenType(v))
+	}
+	if v := expandStringList(m["audience"]); len(v) > 0 {
+		opts = append(opts, ydbcredentials.WithAudience(v[0], v[1:]...))
+	}
+	if v := expandStringList(m["scope"]); len(v) > 0 {
+		opts = append(opts, ydbcredentials.WithScope(v[0], v[1:]...))
+	}
+	if v := expandStringList(m["resource"]); len(v) > 0 {
+		opts = append(opts, ydbcredentials.WithResource(v[0], v[1:]...))
+	}
+
+	// The subject token file is re-read on every exchange: CI and workload identity tokens are
+	// short-lived and rotated on disk.
+	if path := m["subject_token_file"].(string); path != "" {
+		opts = append(opts, ydbcredentials.WithSubjectToken(auth.NewTokenFileSource(path, m["subject_token_type"].(string))))
+	} else if v := m["subject_token"].(string); v != "" {
+		opts = append(opts, ydbcredentials.WithFixedSubjectToken(v, m["subject_token_type"].(string)))
+	}
+
+	if path := m["config_file"].(string); path != "" {
+		return ydbcredentials.NewOauth2TokenExchangeCredentialsFile(path, opts...)
+	}
+	if m["token_endpoint"].(string) == "" {
+		return nil, fmt.Errorf("oauth2_token_exchange: either token_endpoint or config_file must be set")
+	}
+	return ydbcredentials.NewOauth2TokenExchangeCredentials(opts...)
+}
+
+func expandStringList(raw interface{}) []string {
+	list, _ := raw.([]interface{})
+	res := make([]string, 0, len(list))
+	for _, v := range list {
+		if s, ok := v.(string); ok && s != "" {
+			res = append(res, s)
+		}
+	}
+	return res
+}
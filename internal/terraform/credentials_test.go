@@ -0,0 +1,173 @@
+package terraform
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTokenExchangeServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if r.Form.Get("grant_type") != "urn:ietf:params:oauth:grant-type:token-exchange" ||
+			r.Form.Get("subject_token") != "ci-token" ||
+			r.Form.Get("subject_token_type") != "urn:ietf:params:oauth:token-type:jwt" ||
+			r.Form.Get("audience") != "ydb" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_request"}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"exchanged","token_type":"Bearer","expires_in":3600,` +
+			`"issued_token_type":"urn:ietf:params:oauth:token-type:access_token"}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func configureTestProvider(t *testing.T, raw map[string]interface{}) (*Config, error) {
+	t.Helper()
+	d := schema.TestResourceDataRaw(t, Provider().Schema, raw)
+	meta, diags := configureProvider(context.Background(), d)
+	if diags.HasError() {
+		return nil, errors.New(diags[0].Summary)
+	}
+	return meta.(*Config), nil
+}
+
+func TestCredentialsOauth2TokenExchange(t *testing.T) {
+	srv := newTokenExchangeServer(t)
+	cfg, err := configureTestProvider(t, map[string]interface{}{
+		"credentials": []interface{}{map[string]interface{}{
+			"oauth2_token_exchange": []interface{}{map[string]interface{}{
+				"token_endpoint":     srv.URL,
+				"audience":           []interface{}{"ydb"},
+				"subject_token":      "ci-token",
+				"subject_token_type": "urn:ietf:params:oauth:token-type:jwt",
+			}},
+		}},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, cfg.AuthCreds.Credentials)
+
+	token, err := cfg.AuthCreds.Credentials.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer exchanged", token)
+}
+
+func TestCredentialsOauth2TokenExchangeSubjectTokenFile(t *testing.T) {
+	srv := newTokenExchangeServer(t)
+	path := filepath.Join(t.TempDir(), "oidc-token")
+	require.NoError(t, os.WriteFile(path, []byte("ci-token\n"), 0o600))
+
+	cfg, err := configureTestProvider(t, map[string]interface{}{
+		"credentials": []interface{}{map[string]interface{}{
+			"oauth2_token_exchange": []interface{}{map[string]interface{}{
+				"token_endpoint":     srv.URL,
+				"audience":           []interface{}{"ydb"},
+				"subject_token_file": path,
+				"subject_token_type": "urn:ietf:params:oauth:token-type:jwt",
+			}},
+		}},
+	})
+	require.NoError(t, err)
+
+	token, err := cfg.AuthCreds.Credentials.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer exchanged", token)
+}
+
+func TestCredentialsOauth2TokenExchangeRejected(t *testing.T) {
+	srv := newTokenExchangeServer(t)
+	cfg, err := configureTestProvider(t, map[string]interface{}{
+		"credentials": []interface{}{map[string]interface{}{
+			"oauth2_token_exchange": []interface{}{map[string]interface{}{
+				"token_endpoint": srv.URL,
+				"subject_token":  "wrong-token",
+			}},
+		}},
+	})
+	require.NoError(t, err)
+
+	_, err = cfg.AuthCreds.Credentials.Token(context.Background())
+	assert.Error(t, err)
+}
+
+func TestExpandCredentials(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     map[string]interface{}
+		wantNil bool
+		wantErr bool
+	}{
+		{
+			name:    "no block",
+			wantNil: true,
+		},
+		{
+			name: "token file",
+			raw:  map[string]interface{}{"token_file": "/var/run/ydb/token"},
+		},
+		{
+			name: "anonymous",
+			raw:  map[string]interface{}{"anonymous": true},
+		},
+		{
+			name: "metadata",
+			raw:  map[string]interface{}{"metadata": []interface{}{map[string]interface{}{"url": ""}}},
+		},
+		{
+			name: "missing service account key file",
+			raw: map[string]interface{}{"service_account_key": []interface{}{map[string]interface{}{
+				"file":         filepath.Join(t.TempDir(), "missing.json"),
+				"iam_endpoint": "",
+			}}},
+			wantErr: true,
+		},
+		{
+			name: "token exchange without endpoint",
+			raw: map[string]interface{}{"oauth2_token_exchange": []interface{}{map[string]interface{}{
+				"token_endpoint":       "",
+				"config_file":          "",
+				"grant_type":           "",
+				"requested_token_type": "",
+				"subject_token":        "t",
+				"subject_token_file":   "",
+				"subject_token_type":   defaultSubjectTokenType,
+			}}},
+			wantErr: true,
+		},
+		{
+			name:    "empty block",
+			raw:     map[string]interface{}{"anonymous": false},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var raw []interface{}
+			if tt.raw != nil {
+				raw = []interface{}{tt.raw}
+			}
+			creds, err := expandCredentials(raw)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantNil, creds == nil)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package terraform
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/directory"
+)
+
+func ydbDirectoryResource() *schema.Resource {
+	return &schema.Resource{
+		Schema:        directory.ResourceSchema(),
+		SchemaVersion: 0,
+		CreateContext: resourceYDBDirectoryCreate,
+		ReadContext:   resourceYDBDirectoryRead,
+		UpdateContext: resourceYDBDirectoryUpdate,
+		DeleteContext: resourceYDBDirectoryDelete,
+		CustomizeDiff: defaultConnectionStringDiff(),
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithDefaultConnectionString(directory.ImportState),
+		},
+		Timeouts: defaultTimeouts(),
+	}
+}
+
+func ydbSchemeEntriesDataSource() *schema.Resource {
+	return &schema.Resource{
+		Schema:      directory.EntriesDataSourceSchema(),
+		ReadContext: dataSourceYDBSchemeEntriesRead,
+		Timeouts:    defaultTimeouts(),
+	}
+}
+
+func resourceYDBDirectoryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return directory.ResourceCreateFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBDirectoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return directory.ResourceReadFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBDirectoryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return directory.ResourceUpdateFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBDirectoryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return directory.ResourceDeleteFunc(cb)(ctx, d, meta)
+}
+
+func dataSourceYDBSchemeEntriesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	if err := setDefaultConnectionString(d, cfg); err != nil {
+		return diag.FromErr(err)
+	}
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return directory.DataSourceEntriesReadFunc(cb)(ctx, d, meta)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/ydb-platform/terraform-provider-ydb/internal/resources/externaldatasource"
@@ -20,14 +21,17 @@ func ydbExternalDataSourceResource() *schema.Resource {
 		UpdateContext: resourceYDBExternalDataSourceUpdate,
 		DeleteContext: resourceYDBExternalDataSourceDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
-			if err := externaldatasource.ValidateResourceDiffAuth(d); err != nil {
-				return err
-			}
-			return externaldatasource.ValidateResourceDiffSourceType(d)
+			StateContext: importStateWithDefaultConnectionString(nil),
 		},
+		CustomizeDiff: customdiff.All(
+			defaultConnectionStringDiff(),
+			func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+				if err := externaldatasource.ValidateResourceDiffAuth(d); err != nil {
+					return err
+				}
+				return externaldatasource.ValidateResourceDiffSourceType(d)
+			},
+		),
 		Timeouts: defaultTimeouts(),
 	}
 }
@@ -74,6 +78,9 @@ func resourceYDBExternalDataSourceDelete(ctx context.Context, d *schema.Resource
 
 func dataSourceYDBExternalDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*Config)
+	if err := setDefaultConnectionString(d, cfg); err != nil {
+		return diag.FromErr(err)
+	}
 	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
 		return cfg.AuthCreds, nil
 	}
@@ -16,9 +16,11 @@ func ydbExternalTableResource() *schema.Resource {
 		SchemaVersion: 0,
 		CreateContext: resourceYDBExternalTableCreate,
 		ReadContext:   resourceYDBExternalTableRead,
+		UpdateContext: resourceYDBExternalTableUpdate,
 		DeleteContext: resourceYDBExternalTableDelete,
+		CustomizeDiff: defaultConnectionStringDiff(),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateWithDefaultConnectionString(nil),
 		},
 		Timeouts: defaultTimeouts(),
 	}
@@ -48,6 +50,14 @@ func resourceYDBExternalTableRead(ctx context.Context, d *schema.ResourceData, m
 	return et.ResourceReadFunc(cb)(ctx, d, meta)
 }
 
+func resourceYDBExternalTableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return et.ResourceUpdateFunc(cb)(ctx, d, meta)
+}
+
 func resourceYDBExternalTableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*Config)
 	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
@@ -58,6 +68,9 @@ func resourceYDBExternalTableDelete(ctx context.Context, d *schema.ResourceData,
 
 func dataSourceYDBExternalTableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*Config)
+	if err := setDefaultConnectionString(d, cfg); err != nil {
+		return diag.FromErr(err)
+	}
 	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
 		return cfg.AuthCreds, nil
 	}
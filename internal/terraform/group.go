@@ -0,0 +1,50 @@
+package terraform
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/group"
+)
+
+func ydbGroupResource() *schema.Resource {
+	return &schema.Resource{
+		Schema:        group.ResourceSchema(),
+		SchemaVersion: 0,
+		CreateContext: resourceYDBGroupCreate,
+		ReadContext:   resourceYDBGroupRead,
+		DeleteContext: resourceYDBGroupDelete,
+		CustomizeDiff: defaultConnectionStringDiff(),
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithDefaultConnectionString(nil),
+		},
+		Timeouts: defaultTimeouts(),
+	}
+}
+
+func resourceYDBGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return group.ResourceCreateFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return group.ResourceReadFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return group.ResourceDeleteFunc(cb)(ctx, d, meta)
+}
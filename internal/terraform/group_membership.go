@@ -0,0 +1,50 @@
+package terraform
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/groupmembership"
+)
+
+func ydbGroupMembershipResource() *schema.Resource {
+	return &schema.Resource{
+		Schema:        groupmembership.ResourceSchema(),
+		SchemaVersion: 0,
+		CreateContext: resourceYDBGroupMembershipCreate,
+		ReadContext:   resourceYDBGroupMembershipRead,
+		DeleteContext: resourceYDBGroupMembershipDelete,
+		CustomizeDiff: defaultConnectionStringDiff(),
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithDefaultConnectionString(nil),
+		},
+		Timeouts: defaultTimeouts(),
+	}
+}
+
+func resourceYDBGroupMembershipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return groupmembership.ResourceCreateFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBGroupMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return groupmembership.ResourceReadFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBGroupMembershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return groupmembership.ResourceDeleteFunc(cb)(ctx, d, meta)
+}
@@ -18,8 +18,9 @@ func ydbKvResource() *schema.Resource {
 		ReadContext:   resourceYDBKvRead,
 		UpdateContext: resourceYDBKvUpdate,
 		DeleteContext: resourceYDBKvDelete,
+		CustomizeDiff: defaultConnectionStringDiff(),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateWithDefaultConnectionString(nil),
 		},
 		Timeouts: defaultTimeouts(),
 	}
@@ -0,0 +1,59 @@
+package terraform
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/permissions"
+)
+
+func ydbPermissionsResource() *schema.Resource {
+	return &schema.Resource{
+		Schema:        permissions.ResourceSchema(),
+		SchemaVersion: 0,
+		CreateContext: resourceYDBPermissionsCreate,
+		ReadContext:   resourceYDBPermissionsRead,
+		UpdateContext: resourceYDBPermissionsUpdate,
+		DeleteContext: resourceYDBPermissionsDelete,
+		CustomizeDiff: defaultConnectionStringDiff(),
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithDefaultConnectionString(nil),
+		},
+		Timeouts: defaultTimeouts(),
+	}
+}
+
+func resourceYDBPermissionsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return permissions.ResourceCreateFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBPermissionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return permissions.ResourceReadFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBPermissionsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return permissions.ResourceUpdateFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBPermissionsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return permissions.ResourceDeleteFunc(cb)(ctx, d, meta)
+}
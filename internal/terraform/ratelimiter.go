@@ -18,8 +18,9 @@ func ydbRateLimiterResource() *schema.Resource {
 		ReadContext:   resourceYDBRateLimiterRead,
 		UpdateContext: resourceYDBRateLimiterUpdate,
 		DeleteContext: resourceYDBRateLimiterDelete,
+		CustomizeDiff: defaultConnectionStringDiff(),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateWithDefaultConnectionString(nil),
 		},
 		Timeouts: defaultTimeouts(),
 	}
@@ -75,6 +76,9 @@ func resourceYDBRateLimiterDelete(ctx context.Context, d *schema.ResourceData, m
 
 func dataSourceYDBRateLimiterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*Config)
+	if err := setDefaultConnectionString(d, cfg); err != nil {
+		return diag.FromErr(err)
+	}
 	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
 		return cfg.AuthCreds, nil
 	}
@@ -0,0 +1,59 @@
+package terraform
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/resourcepool"
+)
+
+func ydbResourcePoolResource() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourcepool.ResourceSchema(),
+		SchemaVersion: 0,
+		CreateContext: resourceYDBResourcePoolCreate,
+		ReadContext:   resourceYDBResourcePoolRead,
+		UpdateContext: resourceYDBResourcePoolUpdate,
+		DeleteContext: resourceYDBResourcePoolDelete,
+		CustomizeDiff: defaultConnectionStringDiff(),
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithDefaultConnectionString(nil),
+		},
+		Timeouts: defaultTimeouts(),
+	}
+}
+
+func resourceYDBResourcePoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return resourcepool.ResourceCreateFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBResourcePoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return resourcepool.ResourceReadFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBResourcePoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return resourcepool.ResourceUpdateFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBResourcePoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return resourcepool.ResourceDeleteFunc(cb)(ctx, d, meta)
+}
@@ -0,0 +1,59 @@
+package terraform
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/resourcepoolclassifier"
+)
+
+func ydbResourcePoolClassifierResource() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourcepoolclassifier.ResourceSchema(),
+		SchemaVersion: 0,
+		CreateContext: resourceYDBResourcePoolClassifierCreate,
+		ReadContext:   resourceYDBResourcePoolClassifierRead,
+		UpdateContext: resourceYDBResourcePoolClassifierUpdate,
+		DeleteContext: resourceYDBResourcePoolClassifierDelete,
+		CustomizeDiff: defaultConnectionStringDiff(),
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithDefaultConnectionString(nil),
+		},
+		Timeouts: defaultTimeouts(),
+	}
+}
+
+func resourceYDBResourcePoolClassifierCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return resourcepoolclassifier.ResourceCreateFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBResourcePoolClassifierRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return resourcepoolclassifier.ResourceReadFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBResourcePoolClassifierUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return resourcepoolclassifier.ResourceUpdateFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBResourcePoolClassifierDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return resourcepoolclassifier.ResourceDeleteFunc(cb)(ctx, d, meta)
+}
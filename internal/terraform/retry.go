@@ -0,0 +1,81 @@
+package terraform
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/retry"
+)
+
+func retrySchema() *schema.Schema {
+	def := retry.DefaultPolicy()
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Retry policy for schema changes and other mutating operations failing with transient errors (OVERLOADED, UNAVAILABLE, \"path is busy\" conflicts).",
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_attempts": {
+					Type:         schema.TypeInt,
+					Description:  "Total number of attempts including the first one. Set to 1 to disable retries.",
+					Optional:     true,
+					Default:      def.MaxAttempts,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				"base_backoff": {
+					Type:         schema.TypeString,
+					Description:  "Delay before the first retry, doubled on every next one (Go duration, e.g. `500ms`).",
+					Optional:     true,
+					Default:      def.BaseBackoff.String(),
+					ValidateFunc: validateDuration,
+				},
+				"max_backoff": {
+					Type:         schema.TypeString,
+					Description:  "Upper bound of the delay between attempts (Go duration, e.g. `30s`).",
+					Optional:     true,
+					Default:      def.MaxBackoff.String(),
+					ValidateFunc: validateDuration,
+				},
+				"jitter": {
+					Type:         schema.TypeFloat,
+					Description:  "Fraction of every delay that is randomized, from 0 to 1.",
+					Optional:     true,
+					Default:      def.Jitter,
+					ValidateFunc: validation.FloatBetween(0, 1),
+				},
+			},
+		},
+	}
+}
+
+func validateDuration(v interface{}, k string) (warnings []string, errors []error) {
+	if _, err := time.ParseDuration(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %w", k, err))
+	}
+	return
+}
+
+// expandRetryPolicy builds the retry policy from the provider `retry` block, falling back to
+// retry.DefaultPolicy when the block is not set.
+func expandRetryPolicy(raw []interface{}) (retry.Policy, error) {
+	p := retry.DefaultPolicy()
+	if len(raw) == 0 || raw[0] == nil {
+		return p, nil
+	}
+	m := raw[0].(map[string]interface{})
+
+	var err error
+	p.MaxAttempts = m["max_attempts"].(int)
+	if p.BaseBackoff, err = time.ParseDuration(m["base_backoff"].(string)); err != nil {
+		return p, fmt.Errorf("retry.base_backoff: %w", err)
+	}
+	if p.MaxBackoff, err = time.ParseDuration(m["max_backoff"].(string)); err != nil {
+		return p, fmt.Errorf("retry.max_backoff: %w", err)
+	}
+	p.Jitter = m["jitter"].(float64)
+	return p, p.Validate()
+}
@@ -0,0 +1,41 @@
+package terraform
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/retry"
+)
+
+func TestRetryPolicyFromProviderConfig(t *testing.T) {
+	cfg, err := configureTestProvider(t, map[string]interface{}{})
+	require.NoError(t, err)
+	assert.Equal(t, retry.DefaultPolicy(), cfg.AuthCreds.RetryPolicy)
+
+	cfg, err = configureTestProvider(t, map[string]interface{}{
+		"retry": []interface{}{map[string]interface{}{
+			"max_attempts": 10,
+			"base_backoff": "250ms",
+			"max_backoff":  "1m",
+			"jitter":       0.5,
+		}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, retry.Policy{
+		MaxAttempts: 10,
+		BaseBackoff: 250 * time.Millisecond,
+		MaxBackoff:  time.Minute,
+		Jitter:      0.5,
+	}, cfg.AuthCreds.RetryPolicy)
+
+	_, err = configureTestProvider(t, map[string]interface{}{
+		"retry": []interface{}{map[string]interface{}{
+			"base_backoff": "10s",
+			"max_backoff":  "1s",
+		}},
+	})
+	assert.Error(t, err)
+}
@@ -18,8 +18,9 @@ func ydbSecretResource() *schema.Resource {
 		ReadContext:   resourceYDBSecretRead,
 		UpdateContext: resourceYDBSecretUpdate,
 		DeleteContext: resourceYDBSecretDelete,
+		CustomizeDiff: defaultConnectionStringDiff(),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateWithDefaultConnectionString(nil),
 		},
 		Timeouts: defaultTimeouts(),
 	}
@@ -67,6 +68,9 @@ func resourceYDBSecretDelete(ctx context.Context, d *schema.ResourceData, meta i
 
 func dataSourceYDBSecretRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*Config)
+	if err := setDefaultConnectionString(d, cfg); err != nil {
+		return diag.FromErr(err)
+	}
 	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
 		return cfg.AuthCreds, nil
 	}
@@ -0,0 +1,63 @@
+package terraform
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/sequence"
+)
+
+func ydbSequenceResource() *schema.Resource {
+	return &schema.Resource{
+		Schema:        sequence.ResourceSchema(),
+		SchemaVersion: 0,
+		CreateContext: resourceYDBSequenceCreate,
+		ReadContext:   resourceYDBSequenceRead,
+		UpdateContext: resourceYDBSequenceUpdate,
+		DeleteContext: resourceYDBSequenceDelete,
+		CustomizeDiff: customdiff.All(
+			defaultConnectionStringDiff(),
+			sequence.ResourceCustomizeDiff,
+		),
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithDefaultConnectionString(nil),
+		},
+		Timeouts: defaultTimeouts(),
+	}
+}
+
+func resourceYDBSequenceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return sequence.ResourceCreateFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBSequenceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return sequence.ResourceReadFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBSequenceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return sequence.ResourceUpdateFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBSequenceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return sequence.ResourceDeleteFunc(cb)(ctx, d, meta)
+}
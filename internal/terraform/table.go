@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
@@ -12,15 +13,22 @@ import (
 
 func ydbTableResource() *schema.Resource {
 	return &schema.Resource{
-		Schema:        table.ResourceSchema(),
-		SchemaVersion: 0,
-		CreateContext: resourceYDBTableCreate,
-		ReadContext:   resourceYDBTableRead,
-		UpdateContext: resourceYDBTableUpdate,
-		DeleteContext: resourceYDBTableDelete,
-		CustomizeDiff: table.CustomizeDiff,
+		Schema:         table.ResourceSchema(),
+		SchemaVersion:  table.SchemaVersion,
+		StateUpgraders: table.StateUpgraders(),
+		CreateContext:  resourceYDBTableCreate,
+		ReadContext:    resourceYDBTableRead,
+		UpdateContext:  resourceYDBTableUpdate,
+		DeleteContext:  resourceYDBTableDelete,
+		CustomizeDiff: customdiff.All(
+			defaultConnectionStringDiff(),
+			table.CustomizeDiff,
+			resourceYDBTableTablestoreDiff,
+			resourceYDBTableColumnDropDiff,
+			resourceYDBTableMigrationDependentsDiff,
+		),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateWithDefaultConnectionString(nil),
 		},
 		Timeouts: defaultTimeouts(),
 	}
@@ -38,6 +46,33 @@ func ydbTableDataSource() *schema.Resource {
 	}
 }
 
+func resourceYDBTableTablestoreDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+
+	return table.TablestoreSchemaDiffFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBTableColumnDropDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+
+	return table.IndexedColumnDropDiffFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBTableMigrationDependentsDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+
+	return table.MigrationDependentsDiffFunc(cb)(ctx, d, meta)
+}
+
 func resourceYDBTableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*Config)
 	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
@@ -76,6 +111,9 @@ func resourceYDBTableDelete(ctx context.Context, d *schema.ResourceData, meta in
 
 func dataSourceYDBTableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*Config)
+	if err := setDefaultConnectionString(d, cfg); err != nil {
+		return diag.FromErr(err)
+	}
 	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
 		return cfg.AuthCreds, nil
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
@@ -18,8 +19,12 @@ func ydbTableIndexResource() *schema.Resource {
 		ReadContext:   resourceYDBTableIndexRead,
 		UpdateContext: resourceYDBTableIndexUpdate,
 		DeleteContext: resourceYDBTableIndexDelete,
+		CustomizeDiff: customdiff.All(
+			defaultConnectionStringDiff("table_id"),
+			index.ResourceCustomizeDiff,
+		),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importStateWithDefaultConnectionString(nil),
 		},
 		Timeouts: defaultTimeouts(),
 	}
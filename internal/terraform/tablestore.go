@@ -0,0 +1,63 @@
+package terraform
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/tablestore"
+)
+
+func ydbTablestoreResource() *schema.Resource {
+	return &schema.Resource{
+		Schema:        tablestore.ResourceSchema(),
+		SchemaVersion: 0,
+		CreateContext: resourceYDBTablestoreCreate,
+		ReadContext:   resourceYDBTablestoreRead,
+		UpdateContext: resourceYDBTablestoreUpdate,
+		DeleteContext: resourceYDBTablestoreDelete,
+		CustomizeDiff: customdiff.All(
+			defaultConnectionStringDiff(),
+			tablestore.ResourceCustomizeDiff,
+		),
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithDefaultConnectionString(nil),
+		},
+		Timeouts: defaultTimeouts(),
+	}
+}
+
+func resourceYDBTablestoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return tablestore.ResourceCreateFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBTablestoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return tablestore.ResourceReadFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBTablestoreUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return tablestore.ResourceUpdateFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBTablestoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return tablestore.ResourceDeleteFunc(cb)(ctx, d, meta)
+}
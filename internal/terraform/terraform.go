@@ -10,17 +10,28 @@ import (
 	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
 )
 
+const envCAFile = "YDB_SSL_ROOT_CERTIFICATES_FILE"
+
 type Config struct {
-	Endpoint  string
-	AuthCreds auth.YdbCredentials
+	Endpoint string
+	// ConnectionString is the default connection string for resources and data sources that omit their own.
+	ConnectionString string
+	AuthCreds        auth.YdbCredentials
 }
 
 func Provider() *schema.Provider {
 	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"endpoint": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:       schema.TypeString,
+				Optional:   true,
+				Deprecated: "Use connection_string instead.",
+			},
+			"connection_string": {
+				Type:        schema.TypeString,
+				Description: "Default connection string for resources and data sources that do not set their own `connection_string`. Can also be set with the `YDB_CONNECTION_STRING` environment variable.",
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(envConnectionString, nil),
 			},
 			"token": {
 				Type:     schema.TypeString,
@@ -34,6 +45,42 @@ func Provider() *schema.Provider {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"credentials": credentialsSchema(),
+			"retry":       retrySchema(),
+			"tls_ca_file": {
+				Type:          schema.TypeString,
+				Description:   "Path to a PEM file with CA certificates trusted in addition to the system pool. Can also be set with the `YDB_SSL_ROOT_CERTIFICATES_FILE` environment variable.",
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc(envCAFile, nil),
+				ConflictsWith: []string{"tls_ca_pem"},
+			},
+			"tls_ca_pem": {
+				Type:        schema.TypeString,
+				Description: "PEM-encoded CA certificates trusted in addition to the system pool.",
+				Optional:    true,
+			},
+			"tls_client_cert_file": {
+				Type:         schema.TypeString,
+				Description:  "Path to a PEM-encoded client certificate for mutual TLS.",
+				Optional:     true,
+				RequiredWith: []string{"tls_client_key_file"},
+			},
+			"tls_client_key_file": {
+				Type:         schema.TypeString,
+				Description:  "Path to the PEM-encoded private key of `tls_client_cert_file`.",
+				Optional:     true,
+				RequiredWith: []string{"tls_client_cert_file"},
+			},
+			"tls_server_name": {
+				Type:        schema.TypeString,
+				Description: "Server name used to verify the YDB server certificate instead of the endpoint host.",
+				Optional:    true,
+			},
+			"insecure_skip_verify": {
+				Type:        schema.TypeBool,
+				Description: "Do not verify the YDB server certificate. Use only for testing.",
+				Optional:    true,
+			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"ydb_topic":                ydbTopicDataSource(),
@@ -43,18 +90,34 @@ func Provider() *schema.Provider {
 			"ydb_external_data_source": ydbExternalDataSourceDataSource(),
 			"ydb_external_table":       ydbExternalTableDataSource(),
 			"ydb_secret":               ydbSecretDataSource(),
+			"ydb_scheme_entries":       ydbSchemeEntriesDataSource(),
+			"ydb_backup_history":       ydbBackupHistoryDataSource(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"ydb_topic":                ydbTopicResource(),
-			"ydb_table":                ydbTableResource(),
-			"ydb_table_changefeed":     ydbTableChangeFeedResource(),
-			"ydb_table_index":          ydbTableIndexResource(),
-			"ydb_coordination":         ydbCoordinationResource(),
-			"ydb_ratelimiter":          ydbRateLimiterResource(),
-			"ydb_kv_volume":            ydbKvResource(),
-			"ydb_external_data_source": ydbExternalDataSourceResource(),
-			"ydb_external_table":       ydbExternalTableResource(),
-			"ydb_secret":               ydbSecretResource(),
+			"ydb_topic":                    ydbTopicResource(),
+			"ydb_table":                    ydbTableResource(),
+			"ydb_table_changefeed":         ydbTableChangeFeedResource(),
+			"ydb_table_index":              ydbTableIndexResource(),
+			"ydb_coordination":             ydbCoordinationResource(),
+			"ydb_ratelimiter":              ydbRateLimiterResource(),
+			"ydb_kv_volume":                ydbKvResource(),
+			"ydb_external_data_source":     ydbExternalDataSourceResource(),
+			"ydb_external_table":           ydbExternalTableResource(),
+			"ydb_secret":                   ydbSecretResource(),
+			"ydb_user":                     ydbUserResource(),
+			"ydb_group":                    ydbGroupResource(),
+			"ydb_group_membership":         ydbGroupMembershipResource(),
+			"ydb_permissions":              ydbPermissionsResource(),
+			"ydb_directory":                ydbDirectoryResource(),
+			"ydb_view":                     ydbViewResource(),
+			"ydb_resource_pool":            ydbResourcePoolResource(),
+			"ydb_resource_pool_classifier": ydbResourcePoolClassifierResource(),
+			"ydb_async_replication":        ydbAsyncReplicationResource(),
+			"ydb_transfer":                 ydbTransferResource(),
+			"ydb_backup_collection":        ydbBackupCollectionResource(),
+			"ydb_backup":                   ydbBackupResource(),
+			"ydb_tablestore":               ydbTablestoreResource(),
+			"ydb_sequence":                 ydbSequenceResource(),
 		},
 	}
 
@@ -63,12 +126,39 @@ func Provider() *schema.Provider {
 }
 
 func configureProvider(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	connectionString := d.Get("connection_string").(string)
+	if connectionString == "" {
+		connectionString = d.Get("endpoint").(string)
+	}
+	creds, err := expandCredentials(d.Get("credentials").([]interface{}))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	retryPolicy, err := expandRetryPolicy(d.Get("retry").([]interface{}))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	tlsConfig, err := auth.TLSParams{
+		CAFile:             d.Get("tls_ca_file").(string),
+		CAPEM:              d.Get("tls_ca_pem").(string),
+		ClientCertFile:     d.Get("tls_client_cert_file").(string),
+		ClientKeyFile:      d.Get("tls_client_key_file").(string),
+		ServerName:         d.Get("tls_server_name").(string),
+		InsecureSkipVerify: d.Get("insecure_skip_verify").(bool),
+	}.Config()
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
 	cfg := &Config{
-		Endpoint: d.Get("endpoint").(string),
+		Endpoint:         d.Get("endpoint").(string),
+		ConnectionString: connectionString,
 		AuthCreds: auth.YdbCredentials{
-			Token:    d.Get("token").(string),
-			User:     d.Get("user").(string),
-			Password: d.Get("password").(string),
+			Token:       d.Get("token").(string),
+			User:        d.Get("user").(string),
+			Password:    d.Get("password").(string),
+			Credentials: creds,
+			TLSConfig:   tlsConfig,
+			RetryPolicy: retryPolicy,
 		},
 	}
 	return cfg, nil
@@ -0,0 +1,59 @@
+package terraform
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/transfer"
+)
+
+func ydbTransferResource() *schema.Resource {
+	return &schema.Resource{
+		Schema:        transfer.ResourceSchema(),
+		SchemaVersion: 0,
+		CreateContext: resourceYDBTransferCreate,
+		ReadContext:   resourceYDBTransferRead,
+		UpdateContext: resourceYDBTransferUpdate,
+		DeleteContext: resourceYDBTransferDelete,
+		CustomizeDiff: defaultConnectionStringDiff(),
+		Importer: &schema.ResourceImporter{
+			StateContext: importStateWithDefaultConnectionString(nil),
+		},
+		Timeouts: defaultTimeouts(),
+	}
+}
+
+func resourceYDBTransferCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return transfer.ResourceCreateFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBTransferRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return transfer.ResourceReadFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBTransferUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return transfer.ResourceUpdateFunc(cb)(ctx, d, meta)
+}
+
+func resourceYDBTransferDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*Config)
+	cb := func(ctx context.Context) (auth.YdbCredentials, error) {
+		return cfg.AuthCreds, nil
+	}
+	return transfer.ResourceDeleteFunc(cb)(ctx, d, meta)
+}
@@ -0,0 +1,82 @@
+package terraform_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Acceptance tests for ydb_async_replication (see acc_test.go for env and how to run).
+// The replication copies a table of the test database into the same database.
+
+func TestAccYdbAsyncReplication_failover(t *testing.T) {
+	conn := os.Getenv(envAccYDBConnection)
+	root := "tf_acc_" + accRandomHex8(t)
+
+	config := func(state string) string {
+		return accTestConfigPrefix(conn) + fmt.Sprintf(`
+resource "ydb_table" "source" {
+  connection_string = var.connection_string
+  path              = "%[1]s/source"
+
+  column {
+    name = "id"
+    type = "Uint64"
+  }
+
+  primary_key = ["id"]
+}
+
+resource "ydb_async_replication" "test" {
+  connection_string = var.connection_string
+  path              = "%[1]s/replication"
+  state             = %[2]q
+  drop_cascade      = true
+
+  source {
+    connection_string = var.connection_string
+  }
+
+  item {
+    source_path = ydb_table.source.path
+    target_path = "%[1]s/replica"
+  }
+}
+`, root, state)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { accPreCheckYDB(t) },
+		ProviderFactories: accProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config("RUNNING"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ydb_async_replication.test", "item.#", "1"),
+					resource.TestCheckResourceAttr("ydb_async_replication.test", "item.0.target_path", root+"/replica"),
+					resource.TestCheckResourceAttr("ydb_async_replication.test", "consistency_level", "Row"),
+					resource.TestCheckResourceAttrSet("ydb_async_replication.test", "status"),
+				),
+			},
+			{
+				Config:   config("RUNNING"),
+				PlanOnly: true,
+			},
+			{
+				Config: config("DONE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ydb_async_replication.test", "state", "DONE"),
+					resource.TestCheckResourceAttr("ydb_async_replication.test", "status", "done"),
+				),
+			},
+			{
+				ResourceName:            "ydb_async_replication.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"drop_cascade", "lag", "initial_scan_progress"},
+			},
+		},
+	})
+}
@@ -0,0 +1,164 @@
+package terraform_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Acceptance tests for ydb_backup_collection, ydb_backup and ydb_backup_history
+// (see acc_test.go for env and how to run).
+
+func TestAccYdbBackupCollection_fullAndIncremental(t *testing.T) {
+	conn := os.Getenv(envAccYDBConnection)
+	root := "tf_acc_" + accRandomHex8(t)
+
+	config := func(run string) string {
+		return accTestConfigPrefix(conn) + fmt.Sprintf(`
+resource "ydb_table" "orders" {
+  connection_string = var.connection_string
+  path              = "%[1]s/orders"
+
+  column {
+    name = "id"
+    type = "Uint64"
+  }
+
+  primary_key = ["id"]
+}
+
+resource "ydb_backup_collection" "test" {
+  connection_string          = var.connection_string
+  name                       = "%[1]s"
+  tables                     = [ydb_table.orders.path]
+  incremental_backup_enabled = true
+}
+
+resource "ydb_backup" "full" {
+  connection_string = var.connection_string
+  collection        = ydb_backup_collection.test.name
+}
+
+resource "ydb_backup" "incremental" {
+  connection_string = var.connection_string
+  collection        = ydb_backup_collection.test.name
+  incremental       = true
+
+  triggers = {
+    run = %[2]q
+  }
+
+  depends_on = [ydb_backup.full]
+}
+
+data "ydb_backup_history" "test" {
+  connection_string = var.connection_string
+  collection        = ydb_backup_collection.test.name
+
+  depends_on = [ydb_backup.incremental]
+}
+`, root, run)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { accPreCheckYDB(t) },
+		ProviderFactories: accProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ydb_backup.full", "backup_name"),
+					resource.TestCheckResourceAttr("ydb_backup.incremental", "incremental", "true"),
+					resource.TestCheckResourceAttr("data.ydb_backup_history.test", "backups.#", "2"),
+					resource.TestCheckResourceAttrPair("data.ydb_backup_history.test", "last_full_backup", "ydb_backup.full", "backup_name"),
+				),
+			},
+			{
+				Config: config("2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ydb_backup_history.test", "backups.#", "3"),
+					resource.TestCheckResourceAttr("data.ydb_backup_history.test", "backups.2.type", "incremental"),
+				),
+			},
+			{
+				ResourceName:            "ydb_backup_collection.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"tables", "storage", "incremental_backup_enabled"},
+			},
+		},
+	})
+}
+
+// TestAccYdbBackupCollection_tablesInPlace verifies that adding a table to a collection keeps
+// the collection and its backups.
+func TestAccYdbBackupCollection_tablesInPlace(t *testing.T) {
+	conn := os.Getenv(envAccYDBConnection)
+	root := "tf_acc_" + accRandomHex8(t)
+
+	config := func(tables string) string {
+		return accTestConfigPrefix(conn) + fmt.Sprintf(`
+resource "ydb_table" "orders" {
+  connection_string = var.connection_string
+  path              = "%[1]s/orders"
+
+  column {
+    name = "id"
+    type = "Uint64"
+  }
+
+  primary_key = ["id"]
+}
+
+resource "ydb_table" "items" {
+  connection_string = var.connection_string
+  path              = "%[1]s/items"
+
+  column {
+    name = "id"
+    type = "Uint64"
+  }
+
+  primary_key = ["id"]
+}
+
+resource "ydb_backup_collection" "test" {
+  connection_string = var.connection_string
+  name              = "%[1]s"
+  tables            = %[2]s
+}
+
+resource "ydb_backup" "full" {
+  connection_string = var.connection_string
+  collection        = ydb_backup_collection.test.name
+}
+
+data "ydb_backup_history" "test" {
+  connection_string = var.connection_string
+  collection        = ydb_backup_collection.test.name
+
+  depends_on = [ydb_backup.full, ydb_backup_collection.test]
+}
+`, root, tables)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { accPreCheckYDB(t) },
+		ProviderFactories: accProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config("[ydb_table.orders.path]"),
+				Check:  resource.TestCheckResourceAttr("data.ydb_backup_history.test", "backups.#", "1"),
+			},
+			{
+				Config: config("[ydb_table.orders.path, ydb_table.items.path]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ydb_backup_collection.test", "tables.#", "2"),
+					resource.TestCheckResourceAttr("data.ydb_backup_history.test", "backups.#", "1"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,95 @@
+package terraform_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Acceptance tests for ydb_directory and ydb_scheme_entries (see acc_test.go for env and how to run).
+
+func TestAccYdbDirectory_basic(t *testing.T) {
+	conn := os.Getenv(envAccYDBConnection)
+	root := "tf_acc_" + accRandomHex8(t)
+
+	config := func(dirPath string) string {
+		return accTestConfigPrefix(conn) + fmt.Sprintf(`
+resource "ydb_directory" "test" {
+  connection_string = var.connection_string
+  path              = %[2]q
+}
+
+resource "ydb_secret" "test" {
+  connection_string = var.connection_string
+  name              = "${ydb_directory.test.path}/secret"
+  value             = "dir"
+}
+
+data "ydb_scheme_entries" "test" {
+  connection_string = var.connection_string
+  path              = %[1]q
+  recursive         = true
+  types             = ["directory"]
+
+  depends_on = [ydb_secret.test]
+}
+`, root, dirPath)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { accPreCheckYDB(t) },
+		ProviderFactories: accProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config(root + "/nested/dir"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ydb_directory.test", "path", root+"/nested/dir"),
+					resource.TestCheckResourceAttrSet("ydb_directory.test", "full_path"),
+					resource.TestCheckResourceAttr("data.ydb_scheme_entries.test", "paths.#", "2"),
+					resource.TestCheckResourceAttr("data.ydb_scheme_entries.test", "paths.0", root+"/nested"),
+					resource.TestCheckResourceAttr("data.ydb_scheme_entries.test", "entries.1.type", "directory"),
+				),
+			},
+			{
+				Config:   config("/" + root + "/nested/dir/"),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:            "ydb_directory.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"recursive", "remove_only_if_empty", "inherit_permissions"},
+			},
+		},
+	})
+}
+
+func TestAccYdbDirectory_notRecursive(t *testing.T) {
+	conn := os.Getenv(envAccYDBConnection)
+	root := "tf_acc_" + accRandomHex8(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { accPreCheckYDB(t) },
+		ProviderFactories: accProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: accTestConfigPrefix(conn) + fmt.Sprintf(`
+resource "ydb_directory" "parent" {
+  connection_string = var.connection_string
+  path              = %[1]q
+}
+
+resource "ydb_directory" "child" {
+  connection_string   = var.connection_string
+  path                = "${ydb_directory.parent.path}/child"
+  recursive           = false
+  inherit_permissions = false
+}
+`, root),
+				Check: resource.TestCheckResourceAttr("ydb_directory.child", "path", root+"/child"),
+			},
+		},
+	})
+}
@@ -265,6 +265,70 @@ resource "ydb_external_table" "test" {
 	})
 }
 
+// TestAccYdbExternalTable_updateInPlace verifies that location, format and column changes
+// are applied in place via CREATE OR REPLACE; the final step re-plans to assert no drift.
+func TestAccYdbExternalTable_updateInPlace(t *testing.T) {
+	conn := os.Getenv(envAccYDBConnection)
+	suffix := accRandomHex8(t)
+	dsPath := "tf_acc_ext/ds_upd_" + suffix
+	tblPath := "tf_acc_ext/tbl_upd_" + suffix
+
+	config := func(location, format, extraColumn string) string {
+		return accTestConfigPrefix(conn) + fmt.Sprintf(`
+resource "ydb_external_data_source" "s3" {
+  connection_string = var.connection_string
+  path                = %q
+  source_type         = "ObjectStorage"
+  location            = "https://example.com/terraform-acc-bucket/"
+  auth_method         = "NONE"
+}
+
+resource "ydb_external_table" "test" {
+  connection_string  = var.connection_string
+  path                 = %q
+  data_source_path     = ydb_external_data_source.s3.path
+  location             = %q
+  format               = %q
+
+  column {
+    name     = "key"
+    type     = "Utf8"
+    not_null = true
+  }
+  column {
+    name = %q
+    type = "Utf8"
+  }
+}
+`, dsPath, tblPath, location, format, extraColumn)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { accPreCheckYDB(t) },
+		ProviderFactories: accProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config("prefix/", "csv_with_names", "value"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ydb_external_table.test", "location", "prefix/"),
+				),
+			},
+			{
+				Config: config("prefix/v2/", "json_each_row", "payload"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ydb_external_table.test", "location", "prefix/v2/"),
+					resource.TestCheckResourceAttr("ydb_external_table.test", "format", "json_each_row"),
+					resource.TestCheckResourceAttr("ydb_external_table.test", "column.1.name", "payload"),
+				),
+			},
+			{
+				Config:   config("prefix/v2/", "json_each_row", "payload"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccYdbExternalTable_dataSource(t *testing.T) {
 	conn := os.Getenv(envAccYDBConnection)
 	suffix := accRandomHex8(t)
@@ -0,0 +1,75 @@
+package terraform_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Acceptance tests for ydb_permissions (see acc_test.go for env and how to run).
+
+func accPermissionsConfig(conn, suffix, mode string, permissions string) string {
+	return accTestConfigPrefix(conn) + fmt.Sprintf(`
+resource "ydb_user" "test" {
+  connection_string = var.connection_string
+  name              = "tf_acc_user_%[1]s"
+}
+
+resource "ydb_secret" "test" {
+  connection_string = var.connection_string
+  name              = "tf_acc_%[1]s"
+  value             = "permissions"
+}
+
+resource "ydb_permissions" "test" {
+  connection_string = var.connection_string
+  path              = ydb_secret.test.path
+  subject           = ydb_user.test.name
+  mode              = %[2]q
+  permissions       = %[3]s
+}
+`, suffix, mode, permissions)
+}
+
+func TestAccYdbPermissions_basic(t *testing.T) {
+	conn := os.Getenv(envAccYDBConnection)
+	suffix := accRandomHex8(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { accPreCheckYDB(t) },
+		ProviderFactories: accProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: accPermissionsConfig(conn, suffix, "authoritative", `["ydb.generic.read"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ydb_permissions.test", "permissions.#", "1"),
+					resource.TestCheckTypeSetElemAttr("ydb_permissions.test", "permissions.*", "ydb.generic.read"),
+					resource.TestCheckResourceAttrSet("ydb_permissions.test", "owner"),
+				),
+			},
+			{
+				Config: accPermissionsConfig(conn, suffix, "additive", `["ydb.generic.read", "ydb.generic.write"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ydb_permissions.test", "permissions.#", "2"),
+					resource.TestCheckTypeSetElemAttr("ydb_permissions.test", "permissions.*", "ydb.generic.write"),
+				),
+			},
+			{
+				Config: accPermissionsConfig(conn, suffix, "authoritative", `["ydb.generic.write"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ydb_permissions.test", "permissions.#", "1"),
+					resource.TestCheckTypeSetElemAttr("ydb_permissions.test", "permissions.*", "ydb.generic.write"),
+				),
+			},
+			{
+				ResourceName:            "ydb_permissions.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateId:           conn + "?path=tf_acc_" + suffix + "#tf_acc_user_" + suffix,
+				ImportStateVerifyIgnore: []string{"path"},
+			},
+		},
+	})
+}
@@ -0,0 +1,100 @@
+package terraform_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Acceptance tests for ydb_resource_pool and ydb_resource_pool_classifier (see acc_test.go for env and how to run).
+
+func TestAccYdbResourcePool_basic(t *testing.T) {
+	conn := os.Getenv(envAccYDBConnection)
+	name := "tf_acc_" + accRandomHex8(t)
+
+	config := func(limits string) string {
+		return accTestConfigPrefix(conn) + fmt.Sprintf(`
+resource "ydb_resource_pool" "test" {
+  connection_string = var.connection_string
+  name              = %[1]q
+  %[2]s
+}
+
+resource "ydb_resource_pool_classifier" "test" {
+  connection_string = var.connection_string
+  name              = "%[1]s_classifier"
+  resource_pool     = ydb_resource_pool.test.name
+  member_name       = "root"
+  rank              = 1000
+}
+`, name, limits)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { accPreCheckYDB(t) },
+		ProviderFactories: accProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config(`
+  concurrent_query_limit           = 10
+  queue_size                       = 100
+  total_cpu_limit_percent_per_node = 50
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ydb_resource_pool.test", "concurrent_query_limit", "10"),
+					resource.TestCheckResourceAttr("ydb_resource_pool.test", "total_cpu_limit_percent_per_node", "50"),
+					resource.TestCheckResourceAttr("ydb_resource_pool.test", "query_memory_limit_percent_per_node", "-1"),
+					resource.TestCheckResourceAttr("ydb_resource_pool_classifier.test", "resource_pool", name),
+					resource.TestCheckResourceAttr("ydb_resource_pool_classifier.test", "rank", "1000"),
+				),
+			},
+			{
+				Config: config(`
+  concurrent_query_limit              = 20
+  query_memory_limit_percent_per_node = 12.5
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ydb_resource_pool.test", "concurrent_query_limit", "20"),
+					resource.TestCheckResourceAttr("ydb_resource_pool.test", "queue_size", "-1"),
+					resource.TestCheckResourceAttr("ydb_resource_pool.test", "total_cpu_limit_percent_per_node", "-1"),
+					resource.TestCheckResourceAttr("ydb_resource_pool.test", "query_memory_limit_percent_per_node", "12.5"),
+				),
+			},
+			{
+				ResourceName:      "ydb_resource_pool.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "ydb_resource_pool_classifier.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccYdbResourcePool_invalidLimit(t *testing.T) {
+	conn := os.Getenv(envAccYDBConnection)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { accPreCheckYDB(t) },
+		ProviderFactories: accProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: accTestConfigPrefix(conn) + `
+resource "ydb_resource_pool" "test" {
+  connection_string                = var.connection_string
+  name                             = "tf_acc_invalid"
+  total_cpu_limit_percent_per_node = 150
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`-1 \(no limit\) or in the range \(0 - 100\)`),
+			},
+		},
+	})
+}
@@ -182,3 +182,39 @@ resource "ydb_secret" "test" {
 		},
 	})
 }
+
+func TestAccYdbSecret_providerConnectionString(t *testing.T) {
+	conn := os.Getenv(envAccYDBConnection)
+	name := "tf_acc_" + accRandomHex8(t)
+	cfg := accProviderBlockWithConnectionString(conn) + fmt.Sprintf(`
+resource "ydb_secret" "test" {
+  name  = %q
+  value = "provider-default"
+}
+`, name)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { accPreCheckYDB(t) },
+		ProviderFactories: accProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ydb_secret.test", "name", name),
+					resource.TestCheckResourceAttr("ydb_secret.test", "connection_string", conn),
+				),
+			},
+			{
+				Config:   cfg,
+				PlanOnly: true,
+			},
+			{
+				ResourceName:            "ydb_secret.test",
+				ImportState:             true,
+				ImportStateId:           name,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"value", "inherit_permissions"},
+			},
+		},
+	})
+}
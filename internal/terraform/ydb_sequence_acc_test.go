@@ -0,0 +1,88 @@
+package terraform_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Acceptance tests for serial columns and ydb_sequence (see acc_test.go for env and how to run).
+
+func TestAccYdbSequence_serialColumn(t *testing.T) {
+	conn := os.Getenv(envAccYDBConnection)
+	root := "tf_acc_" + accRandomHex8(t)
+
+	config := func(table string, startWith, increment int) string {
+		return accTestConfigPrefix(conn) + fmt.Sprintf(`
+resource "ydb_table" "test" {
+  connection_string = var.connection_string
+  path              = "%[1]s/%[4]s"
+  allow_rename      = true
+
+  column {
+    name = "id"
+    type = "BigSerial"
+  }
+  column {
+    name = "customer"
+    type = "Utf8"
+  }
+
+  primary_key = ["id"]
+}
+
+resource "ydb_sequence" "test" {
+  connection_string = var.connection_string
+  table_path        = ydb_table.test.path
+  column            = "id"
+  start_with        = %[2]d
+  increment         = %[3]d
+}
+`, root, startWith, increment, table)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { accPreCheckYDB(t) },
+		ProviderFactories: accProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config("orders", 100, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("ydb_table.test", "column.*", map[string]string{
+						"name": "id",
+						"type": "BigSerial",
+					}),
+					resource.TestCheckResourceAttr("ydb_sequence.test", "start_with", "100"),
+					resource.TestCheckResourceAttr("ydb_sequence.test", "increment", "1"),
+					resource.TestCheckResourceAttrSet("ydb_sequence.test", "max_value"),
+				),
+			},
+			{
+				Config:   config("orders", 100, 1),
+				PlanOnly: true,
+			},
+			{
+				Config: config("orders", 500, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ydb_sequence.test", "start_with", "500"),
+					resource.TestCheckResourceAttr("ydb_sequence.test", "increment", "5"),
+				),
+			},
+			{
+				// The sequence moves with the renamed table instead of being replaced.
+				Config: config("orders_v2", 500, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ydb_sequence.test", "table_path", root+"/orders_v2"),
+					resource.TestCheckResourceAttr("ydb_sequence.test", "start_with", "500"),
+				),
+			},
+			{
+				ResourceName:      "ydb_sequence.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 // TestAccYdbTable_columnTypeChangePlanError verifies that changing a column type fails at plan time.
@@ -134,3 +135,411 @@ resource "ydb_table" "test" {
 		},
 	})
 }
+
+// TestAccYdbTable_columnDefault verifies that column defaults are created, read back without drift,
+// applied to added columns and cannot be changed in place.
+func TestAccYdbTable_columnDefault(t *testing.T) {
+	conn := os.Getenv(envAccYDBConnection)
+	suffix := accRandomHex8(t)
+	tblPath := "tf_acc_col_default/tbl_" + suffix
+
+	config := func(statusDefault, extraColumn string) string {
+		return accTestConfigPrefix(conn) + fmt.Sprintf(`
+resource "ydb_table" "test" {
+  connection_string = var.connection_string
+  path              = %q
+
+  column {
+    name = "pk"
+    type = "Uint64"
+  }
+  column {
+    name     = "status"
+    type     = "Utf8"
+    not_null = true
+    default  = %q
+  }
+  column {
+    name    = "created_at"
+    type    = "Timestamp"
+    default = "2024-01-01T00:00:00Z"
+  }
+  %s
+
+  primary_key = ["pk"]
+}
+`, tblPath, statusDefault, extraColumn)
+	}
+	attemptsColumn := `
+  column {
+    name    = "attempts"
+    type    = "Uint32"
+    default = "3"
+  }`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { accPreCheckYDB(t) },
+		ProviderFactories: accProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config(`say "new"`, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("ydb_table.test", "column.*", map[string]string{
+						"name":    "status",
+						"default": `say "new"`,
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("ydb_table.test", "column.*", map[string]string{
+						"name":    "created_at",
+						"default": "2024-01-01T00:00:00Z",
+					}),
+				),
+			},
+			{
+				Config: config(`say "new"`, attemptsColumn),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("ydb_table.test", "column.*", map[string]string{
+						"name":    "attempts",
+						"default": "3",
+					}),
+				),
+			},
+			{
+				Config:      config("done", attemptsColumn),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`changing column "status" default from "say \\"new\\"" to "done" is not supported`),
+			},
+		},
+	})
+}
+
+// TestAccYdbTable_copyMigration verifies that a primary key change with the copy migration strategy
+// keeps the table in place and records a backup of the old one.
+func TestAccYdbTable_copyMigration(t *testing.T) {
+	conn := os.Getenv(envAccYDBConnection)
+	suffix := accRandomHex8(t)
+	tblPath := "tf_acc_migration/tbl_" + suffix
+
+	config := func(primaryKey string) string {
+		return accTestConfigPrefix(conn) + fmt.Sprintf(`
+resource "ydb_table" "test" {
+  connection_string          = var.connection_string
+  path                       = %q
+  migration_strategy         = "copy"
+  migration_backup_retention = "1h"
+
+  column {
+    name = "a"
+    type = "Uint64"
+  }
+  column {
+    name = "b"
+    type = "Utf8"
+  }
+
+  primary_key = %s
+}
+`, tblPath, primaryKey)
+	}
+
+	var id string
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { accPreCheckYDB(t) },
+		ProviderFactories: accProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config(`["a"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ydb_table.test", "migration_backups.#", "0"),
+					func(s *terraform.State) error {
+						id = s.RootModule().Resources["ydb_table.test"].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: config(`["a", "b"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ydb_table.test", "primary_key.#", "2"),
+					resource.TestCheckResourceAttr("ydb_table.test", "migration_backups.#", "1"),
+					resource.TestMatchResourceAttr("ydb_table.test", "migration_backups.0.path",
+						regexp.MustCompile(`^`+regexp.QuoteMeta(tblPath)+`_tf_backup_\d{14}$`)),
+					resource.TestCheckResourceAttrSet("ydb_table.test", "migration_backups.0.expires_at"),
+					func(s *terraform.State) error {
+						if got := s.RootModule().Resources["ydb_table.test"].Primary.ID; got != id {
+							return fmt.Errorf("table was replaced: id %q != %q", got, id)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+// TestAccYdbTable_columnFamilies verifies that families are added and columns moved between them in place.
+func TestAccYdbTable_columnFamilies(t *testing.T) {
+	conn := os.Getenv(envAccYDBConnection)
+	suffix := accRandomHex8(t)
+	tblPath := "tf_acc_col_family/tbl_" + suffix
+
+	config := func(payloadFamily, families string) string {
+		return accTestConfigPrefix(conn) + fmt.Sprintf(`
+resource "ydb_table" "test" {
+  connection_string = var.connection_string
+  path              = %q
+
+  column {
+    name = "pk"
+    type = "Uint64"
+  }
+  column {
+    name   = "payload"
+    type   = "String"
+    family = %q
+  }
+
+  primary_key = ["pk"]
+  %s
+}
+`, tblPath, payloadFamily, families)
+	}
+	coldFamily := func(compression string) string {
+		return fmt.Sprintf(`
+  family {
+    name        = "cold"
+    data        = "ssd"
+    compression = %q
+  }`, compression)
+	}
+
+	var id string
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { accPreCheckYDB(t) },
+		ProviderFactories: accProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config("default", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ydb_table.test", "family.#", "0"),
+					func(s *terraform.State) error {
+						id = s.RootModule().Resources["ydb_table.test"].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: config("cold", coldFamily("lz4")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ydb_table.test", "family.#", "1"),
+					resource.TestCheckResourceAttr("ydb_table.test", "family.0.compression", "lz4"),
+					resource.TestCheckTypeSetElemNestedAttrs("ydb_table.test", "column.*", map[string]string{
+						"name":   "payload",
+						"family": "cold",
+					}),
+					func(s *terraform.State) error {
+						if got := s.RootModule().Resources["ydb_table.test"].Primary.ID; got != id {
+							return fmt.Errorf("table was replaced: id %q != %q", got, id)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: config("cold", coldFamily("off")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ydb_table.test", "family.0.compression", "off"),
+				),
+			},
+			{
+				Config:      config("default", ""),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`column families cannot be dropped`),
+			},
+		},
+	})
+}
+
+// TestAccYdbTable_columnDrop verifies that removed columns are dropped only with allow_column_drop
+// and that columns used by an index are refused.
+func TestAccYdbTable_columnDrop(t *testing.T) {
+	conn := os.Getenv(envAccYDBConnection)
+	suffix := accRandomHex8(t)
+	tblPath := "tf_acc_col_drop/tbl_" + suffix
+
+	config := func(allowDrop bool, columns ...string) string {
+		var blocks string
+		for _, c := range columns {
+			blocks += fmt.Sprintf(`
+  column {
+    name = %q
+    type = "Utf8"
+  }`, c)
+		}
+		return accTestConfigPrefix(conn) + fmt.Sprintf(`
+resource "ydb_table" "test" {
+  connection_string = var.connection_string
+  path              = %q
+  allow_column_drop = %t
+%s
+
+  primary_key = ["pk"]
+}
+
+resource "ydb_table_index" "test" {
+  table_id = ydb_table.test.id
+  name     = "by_user"
+  type     = "global_sync"
+  columns  = ["user_id"]
+  cover    = ["email"]
+}
+`, tblPath, allowDrop, blocks)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { accPreCheckYDB(t) },
+		ProviderFactories: accProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config(false, "pk", "user_id", "email", "legacy"),
+			},
+			{
+				Config:      config(false, "pk", "user_id", "email"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Set allow_column_drop = true to drop them`),
+			},
+			{
+				Config:      config(true, "pk", "user_id", "legacy"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`column "email" is covered by index "by_user"`),
+			},
+			{
+				Config: config(true, "pk", "user_id", "email"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ydb_table.test", "column.#", "3"),
+					resource.TestCheckResourceAttr("ydb_table.test", "dropped_columns.#", "1"),
+					resource.TestCheckResourceAttr("ydb_table.test", "dropped_columns.0", "legacy"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccYdbTable_readReplicas verifies that the read_replicas block is applied and read back
+// without drift, and that the deprecated string form describing the same settings is no change.
+func TestAccYdbTable_readReplicas(t *testing.T) {
+	conn := os.Getenv(envAccYDBConnection)
+	tblPath := "tf_acc_read_replicas/tbl_" + accRandomHex8(t)
+
+	config := func(replicas string) string {
+		return accTestConfigPrefix(conn) + fmt.Sprintf(`
+resource "ydb_table" "test" {
+  connection_string = var.connection_string
+  path              = %q
+
+  column {
+    name = "pk"
+    type = "Utf8"
+  }
+
+  primary_key = ["pk"]
+%s
+}
+`, tblPath, replicas)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { accPreCheckYDB(t) },
+		ProviderFactories: accProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config(`
+  read_replicas {
+    mode  = "ANY_AZ"
+    count = 1
+  }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ydb_table.test", "read_replicas.0.mode", "ANY_AZ"),
+					resource.TestCheckResourceAttr("ydb_table.test", "read_replicas.0.count", "1"),
+					resource.TestCheckResourceAttr("ydb_table.test", "read_replicas_settings", "ANY_AZ:1"),
+				),
+			},
+			{
+				Config:   config(`  read_replicas_settings = "any_az:1"`),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+// TestAccYdbTable_partitionAtKeys verifies that a table with a composite Timestamp and Uuid key
+// is split at typed keys, including NULL, that the split points are read back without drift and
+// that a malformed key fails at plan time.
+func TestAccYdbTable_partitionAtKeys(t *testing.T) {
+	conn := os.Getenv(envAccYDBConnection)
+	tblPath := "tf_acc_partition_at_keys/tbl_" + accRandomHex8(t)
+
+	config := func(keys string) string {
+		return accTestConfigPrefix(conn) + fmt.Sprintf(`
+resource "ydb_table" "test" {
+  connection_string = var.connection_string
+  path              = %q
+
+  column {
+    name = "ts"
+    type = "Timestamp"
+  }
+  column {
+    name = "id"
+    type = "Uuid"
+  }
+
+  primary_key = ["ts", "id"]
+
+  partitioning_settings {
+    auto_partitioning_by_load         = false
+    auto_partitioning_by_size_enabled = false
+%s
+  }
+}
+`, tblPath, keys)
+	}
+	keys := `
+    partition_at_keys {
+      key {
+        null = true
+      }
+      key {
+        value = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+      }
+    }
+    partition_at_keys {
+      keys = ["2024-01-01T00:00:00+03:00"]
+    }`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { accPreCheckYDB(t) },
+		ProviderFactories: accProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config(keys),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ydb_table.test", "partitioning_settings.0.partition_at_keys.#", "2"),
+					resource.TestCheckResourceAttr("ydb_table.test", "partitioning_settings.0.partition_at_keys.0.key.0.null", "true"),
+					resource.TestCheckResourceAttr("ydb_table.test", "partitioning_settings.0.partition_at_keys.1.keys.0", "2024-01-01T00:00:00+03:00"),
+				),
+			},
+			{
+				Config:   config(keys),
+				PlanOnly: true,
+			},
+			{
+				Config: config(`
+    partition_at_keys {
+      keys = ["2024-01-01"]
+    }`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`partition_at_keys\[0\]\.keys\[0\] "2024-01-01" for column "ts" of type Timestamp`),
+			},
+		},
+	})
+}
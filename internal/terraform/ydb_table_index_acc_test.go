@@ -0,0 +1,129 @@
+package terraform_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func accTableIndexConfig(conn, tblPath, cover string) string {
+	return accTestConfigPrefix(conn) + fmt.Sprintf(`
+resource "ydb_table" "test" {
+  connection_string = var.connection_string
+  path              = %q
+
+  column {
+    name = "pk"
+    type = "Utf8"
+  }
+  column {
+    name = "user_id"
+    type = "Uint64"
+  }
+  column {
+    name = "payload"
+    type = "Utf8"
+  }
+
+  primary_key = ["pk"]
+}
+
+resource "ydb_table_index" "test" {
+  table_id             = ydb_table.test.id
+  name                 = "by_user"
+  type                 = "global_sync"
+  columns              = ["user_id"]
+  cover                = %s
+  replacement_strategy = "build_and_swap"
+}
+`, tblPath, cover)
+}
+
+// TestAccYdbTableIndex_buildAndSwap verifies that a definition change with the build_and_swap
+// strategy is applied in place and leaves the index under its original name.
+func TestAccYdbTableIndex_buildAndSwap(t *testing.T) {
+	conn := os.Getenv(envAccYDBConnection)
+	tblPath := "tf_acc_index/tbl_" + accRandomHex8(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { accPreCheckYDB(t) },
+		ProviderFactories: accProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: accTableIndexConfig(conn, tblPath, "[]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ydb_table_index.test", "cover.#", "0"),
+				),
+			},
+			{
+				Config: accTableIndexConfig(conn, tblPath, `["payload"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ydb_table_index.test", "cover.#", "1"),
+					resource.TestCheckResourceAttr("ydb_table_index.test", "cover.0", "payload"),
+					resource.TestCheckResourceAttr("ydb_table_index.test", "name", "by_user"),
+				),
+			},
+		},
+	})
+}
+
+func accTableRenameConfig(conn, tblPath string) string {
+	return accTestConfigPrefix(conn) + fmt.Sprintf(`
+resource "ydb_table" "test" {
+  connection_string = var.connection_string
+  path              = %q
+  allow_rename      = true
+
+  column {
+    name = "pk"
+    type = "Utf8"
+  }
+  column {
+    name = "user_id"
+    type = "Uint64"
+  }
+
+  primary_key = ["pk"]
+}
+
+resource "ydb_table_index" "test" {
+  table_id = ydb_table.test.id
+  name     = "by_user"
+  type     = "global_sync"
+  columns  = ["user_id"]
+}
+`, tblPath)
+}
+
+// TestAccYdbTable_renameWithIndex verifies that a path change with allow_rename renames the table
+// and that the index referencing it follows the table to its new path.
+func TestAccYdbTable_renameWithIndex(t *testing.T) {
+	conn := os.Getenv(envAccYDBConnection)
+	suffix := accRandomHex8(t)
+	tblPath := "tf_acc_rename/tbl_" + suffix
+	renamedPath := "tf_acc_rename/moved/tbl_" + suffix
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { accPreCheckYDB(t) },
+		ProviderFactories: accProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: accTableRenameConfig(conn, tblPath),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ydb_table_index.test", "table_path", tblPath),
+				),
+			},
+			{
+				Config: accTableRenameConfig(conn, renamedPath),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ydb_table.test", "path", renamedPath),
+					resource.TestCheckResourceAttrPair("ydb_table_index.test", "table_id", "ydb_table.test", "id"),
+					resource.TestCheckResourceAttr("ydb_table_index.test", "table_path", renamedPath),
+					resource.TestCheckResourceAttr("ydb_table_index.test", "name", "by_user"),
+				),
+			},
+		},
+	})
+}
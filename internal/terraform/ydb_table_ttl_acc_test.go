@@ -3,6 +3,7 @@ package terraform_test
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -58,3 +59,64 @@ resource "ydb_table" "test" {
 		},
 	})
 }
+
+// TestAccYdbTable_ttlTiersPlanError verifies that TTL tiers are validated at plan time.
+func TestAccYdbTable_ttlTiersPlanError(t *testing.T) {
+	conn := os.Getenv(envAccYDBConnection)
+	suffix := accRandomHex8(t)
+	tblPath := "tf_acc_ttl_tiers/tbl_" + suffix
+
+	config := func(tiers string) string {
+		return accTestConfigPrefix(conn) + fmt.Sprintf(`
+resource "ydb_table" "test" {
+  connection_string = var.connection_string
+  path              = %q
+  store             = "column"
+
+  column {
+    name     = "ts"
+    type     = "Timestamp"
+    not_null = true
+  }
+
+  primary_key = ["ts"]
+
+  ttl {
+    column_name = "ts"
+    %s
+  }
+}
+`, tblPath, tiers)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { accPreCheckYDB(t) },
+		ProviderFactories: accProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config(`
+    tier {
+      expire_interval      = "P2D"
+      external_data_source = "cold"
+    }
+    tier {
+      expire_interval = "P1D"
+    }`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`tiers must be ordered by increasing expire_interval`),
+			},
+			{
+				Config: config(`
+    tier {
+      expire_interval = "P1D"
+    }
+    tier {
+      expire_interval      = "P2D"
+      external_data_source = "cold"
+    }`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`only the last tier can delete rows`),
+			},
+		},
+	})
+}
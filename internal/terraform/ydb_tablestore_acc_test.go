@@ -0,0 +1,94 @@
+package terraform_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Acceptance tests for ydb_tablestore and column tables placed in it (see acc_test.go for env and how to run).
+
+func testAccYdbTablestoreConfig(conn, root, tablePath string) string {
+	return accTestConfigPrefix(conn) + fmt.Sprintf(`
+resource "ydb_tablestore" "test" {
+  connection_string = var.connection_string
+  path              = "%[1]s/store"
+  shards_count      = 2
+
+  column {
+    name     = "id"
+    type     = "Uint64"
+    not_null = true
+  }
+  column {
+    name = "payload"
+    type = "Utf8"
+  }
+
+  primary_key = ["id"]
+}
+
+resource "ydb_table" "test" {
+  connection_string = var.connection_string
+  tablestore        = ydb_tablestore.test.path
+  path              = %[2]q
+  store             = "column"
+
+  column {
+    name     = "id"
+    type     = "Uint64"
+    not_null = true
+  }
+  column {
+    name = "payload"
+    type = "Utf8"
+  }
+
+  primary_key = ["id"]
+}
+`, root, tablePath)
+}
+
+func TestAccYdbTablestore_basic(t *testing.T) {
+	conn := os.Getenv(envAccYDBConnection)
+	root := "tf_acc_" + accRandomHex8(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { accPreCheckYDB(t) },
+		ProviderFactories: accProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccYdbTablestoreConfig(conn, root, root+"/outside"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`must be located inside tablestore`),
+			},
+			{
+				Config: testAccYdbTablestoreConfig(conn, root, root+"/store/events"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ydb_tablestore.test", "column.#", "2"),
+					resource.TestCheckResourceAttr("ydb_tablestore.test", "primary_key.0", "id"),
+					resource.TestCheckResourceAttr("ydb_table.test", "tablestore", root+"/store"),
+					resource.TestCheckResourceAttr("ydb_table.test", "store", "column"),
+				),
+			},
+			{
+				Config:   testAccYdbTablestoreConfig(conn, root, root+"/store/events"),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      "ydb_tablestore.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:            "ydb_table.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"partitioning_settings", "key_bloom_filter", "read_replicas_settings", "attributes"},
+			},
+		},
+	})
+}
@@ -0,0 +1,96 @@
+package terraform_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Acceptance tests for ydb_transfer (see acc_test.go for env and how to run).
+// The transfer reads a topic of the test database into a table of the same database.
+
+func TestAccYdbTransfer_pauseAndChangeLambda(t *testing.T) {
+	conn := os.Getenv(envAccYDBConnection)
+	root := "tf_acc_" + accRandomHex8(t)
+
+	config := func(state, column string) string {
+		return accTestConfigPrefix(conn) + fmt.Sprintf(`
+resource "ydb_topic" "source" {
+  database_endpoint = var.connection_string
+  name              = "%[1]s/topic"
+}
+
+resource "ydb_table" "target" {
+  connection_string = var.connection_string
+  path              = "%[1]s/table"
+
+  column {
+    name     = "offset"
+    type     = "Uint64"
+    not_null = true
+  }
+  column {
+    name = "message"
+    type = "Utf8"
+  }
+
+  primary_key = ["offset"]
+}
+
+resource "ydb_transfer" "test" {
+  connection_string     = var.connection_string
+  path                  = "%[1]s/transfer"
+  source_topic          = ydb_topic.source.name
+  target_table          = ydb_table.target.path
+  state                 = %[2]q
+  flush_interval        = "10s"
+  transformation_lambda = <<-YQL
+    ($msg) -> {
+      return [<| offset: $msg._offset, %[3]s: CAST($msg._data AS Utf8) |>];
+    }
+  YQL
+}
+`, root, state, column)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { accPreCheckYDB(t) },
+		ProviderFactories: accProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config("RUNNING", "message"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ydb_transfer.test", "state", "RUNNING"),
+					resource.TestCheckResourceAttr("ydb_transfer.test", "flush_interval", "10s"),
+					resource.TestCheckResourceAttrSet("ydb_transfer.test", "consumer"),
+					resource.TestCheckResourceAttrSet("ydb_transfer.test", "status"),
+				),
+			},
+			{
+				Config:   config("RUNNING", "message"),
+				PlanOnly: true,
+			},
+			{
+				Config: config("PAUSED", "message"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ydb_transfer.test", "state", "PAUSED"),
+					resource.TestCheckResourceAttr("ydb_transfer.test", "status", "paused"),
+				),
+			},
+			{
+				Config: config("PAUSED", "`message`"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ydb_transfer.test", "status", "paused"),
+				),
+			},
+			{
+				ResourceName:            "ydb_transfer.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"transformation_lambda", "errors"},
+			},
+		},
+	})
+}
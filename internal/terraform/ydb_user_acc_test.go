@@ -0,0 +1,105 @@
+package terraform_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Acceptance tests for ydb_user, ydb_group and ydb_group_membership (see acc_test.go for env and how to run).
+
+func TestAccYdbUser_basic(t *testing.T) {
+	conn := os.Getenv(envAccYDBConnection)
+	name := "tf_acc_" + accRandomHex8(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { accPreCheckYDB(t) },
+		ProviderFactories: accProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: accTestConfigPrefix(conn) + fmt.Sprintf(`
+resource "ydb_user" "test" {
+  connection_string = var.connection_string
+  name              = %q
+  password          = "first-password"
+}
+`, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ydb_user.test", "name", name),
+					resource.TestCheckResourceAttr("ydb_user.test", "login", "true"),
+					resource.TestCheckResourceAttrSet("ydb_user.test", "id"),
+				),
+			},
+			{
+				Config: accTestConfigPrefix(conn) + fmt.Sprintf(`
+resource "ydb_user" "test" {
+  connection_string = var.connection_string
+  name              = %q
+  login             = false
+
+  password_command {
+    path = "/bin/sh"
+    args = ["-c", "echo second-password"]
+  }
+}
+`, name),
+				Check: resource.TestCheckResourceAttr("ydb_user.test", "login", "false"),
+			},
+			{
+				ResourceName:            "ydb_user.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password_command"},
+			},
+		},
+	})
+}
+
+func TestAccYdbGroupMembership_basic(t *testing.T) {
+	conn := os.Getenv(envAccYDBConnection)
+	suffix := accRandomHex8(t)
+	cfg := accTestConfigPrefix(conn) + fmt.Sprintf(`
+resource "ydb_user" "test" {
+  connection_string = var.connection_string
+  name              = "tf_acc_user_%[1]s"
+}
+
+resource "ydb_group" "test" {
+  connection_string = var.connection_string
+  name              = "tf_acc_group_%[1]s"
+}
+
+resource "ydb_group_membership" "test" {
+  connection_string = var.connection_string
+  group             = ydb_group.test.name
+  member            = ydb_user.test.name
+}
+`, suffix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { accPreCheckYDB(t) },
+		ProviderFactories: accProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ydb_group.test", "name", "tf_acc_group_"+suffix),
+					resource.TestCheckResourceAttr("ydb_group_membership.test", "group", "tf_acc_group_"+suffix),
+					resource.TestCheckResourceAttr("ydb_group_membership.test", "member", "tf_acc_user_"+suffix),
+				),
+			},
+			{
+				ResourceName:      "ydb_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "ydb_group_membership.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
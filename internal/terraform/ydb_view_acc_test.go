@@ -0,0 +1,78 @@
+package terraform_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// Acceptance tests for ydb_view (see acc_test.go for env and how to run).
+
+func TestAccYdbView_basic(t *testing.T) {
+	conn := os.Getenv(envAccYDBConnection)
+	root := "tf_acc_" + accRandomHex8(t)
+
+	config := func(query string) string {
+		return accTestConfigPrefix(conn) + fmt.Sprintf(`
+resource "ydb_table" "test" {
+  connection_string = var.connection_string
+  path              = "%[1]s/orders"
+
+  column {
+    name = "id"
+    type = "Uint64"
+  }
+  column {
+    name = "amount"
+    type = "Int64"
+  }
+
+  primary_key = ["id"]
+}
+
+resource "ydb_view" "test" {
+  connection_string = var.connection_string
+  path              = "%[1]s/orders_view"
+  query             = <<-EOT
+    %[2]s
+  EOT
+
+  depends_on = [ydb_table.test]
+}
+`, root, query)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { accPreCheckYDB(t) },
+		ProviderFactories: accProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config(fmt.Sprintf("SELECT id FROM `%s/orders`;", root)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ydb_view.test", "path", root+"/orders_view"),
+					resource.TestCheckResourceAttr("ydb_view.test", "security_invoker", "true"),
+					resource.TestCheckResourceAttrSet("ydb_view.test", "full_path"),
+				),
+			},
+			{
+				Config:   config(fmt.Sprintf("SELECT id FROM `%s/orders`;", root)),
+				PlanOnly: true,
+			},
+			{
+				Config: config(fmt.Sprintf("SELECT id, amount FROM `%s/orders`", root)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ydb_view.test", "path", root+"/orders_view"),
+				),
+			},
+			{
+				ResourceName:      "ydb_view.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The imported query is the text stored by YDB, without the heredoc whitespace.
+				ImportStateVerifyIgnore: []string{"query"},
+			},
+		},
+	})
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 
+	"github.com/ydb-platform/terraform-provider-ydb/internal/pool"
 	"github.com/ydb-platform/terraform-provider-ydb/ydb"
 )
 
@@ -20,4 +21,7 @@ func main() {
 	}
 
 	plugin.Serve(opts)
+
+	// Serve returns once Terraform is done with the provider process.
+	_ = pool.Default.Close()
 }
@@ -0,0 +1,187 @@
+package asyncreplication
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	replicationHandler "github.com/ydb-platform/terraform-provider-ydb/internal/resources/asyncreplication"
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
+)
+
+func ResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"connection_string": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "Connection string of the target database the replication is created in.",
+		},
+		"path": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			Description:  "Replication path relative to the database root.",
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		},
+		"source": {
+			Type:        schema.TypeList,
+			Required:    true,
+			MaxItems:    1,
+			Description: "Connection to the source database.",
+			Elem:        helpers.ReplicationSourceResource(),
+		},
+		"item": {
+			Type:        schema.TypeList,
+			Required:    true,
+			Description: "Replicated tables. YDB cannot change the tables of an existing replication, so changes are rejected at plan time.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"source_path": {
+						Type:         schema.TypeString,
+						Required:     true,
+						Description:  "Table path in the source database.",
+						ValidateFunc: validation.StringIsNotWhiteSpace,
+					},
+					"target_path": {
+						Type:         schema.TypeString,
+						Required:     true,
+						Description:  "Path of the replica in the target database.",
+						ValidateFunc: validation.StringIsNotWhiteSpace,
+					},
+				},
+			},
+		},
+		"consistency_level": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      replicationHandler.ConsistencyLevelRow,
+			Description:  "Consistency of the replicas: `Row` or `Global`.",
+			ValidateFunc: validation.StringInSlice([]string{replicationHandler.ConsistencyLevelRow, replicationHandler.ConsistencyLevelGlobal}, false),
+		},
+		"commit_interval": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ForceNew:         true,
+			Description:      "How often changes are committed with `Global` consistency, a Go duration (e.g. `10s`).",
+			ValidateFunc:     helpers.ValidatePositiveDuration,
+			DiffSuppressFunc: helpers.SuppressDurationDiff,
+		},
+		"state": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      replicationHandler.StateRunning,
+			Description:  "Desired state: `RUNNING` or `DONE`. Switching to `DONE` stops the replication and makes the replicas writable (failover). A replication cannot leave `DONE`, switching back recreates it.",
+			ValidateFunc: validation.StringInSlice([]string{replicationHandler.StateRunning, replicationHandler.StateDone}, false),
+		},
+		"drop_cascade": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Drop the replicas together with the replication on destroy.",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Observed state of the replication: `running`, `error`, `done` or `paused`.",
+		},
+		"lag": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Replication lag of a running replication, a Go duration.",
+		},
+		"initial_scan_progress": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Progress of the initial scan of the source tables, in percent.",
+		},
+		"errors": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Issues of a replication in the `error` state.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+// ResourceCustomizeDiff recreates a replication that should leave the DONE state and rejects
+// item changes of an existing replication and commit_interval without Global consistency.
+func ResourceCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	// ALTER ASYNC REPLICATION cannot add or remove tables. Recreating the replication would
+	// either drop every replica with drop_cascade or fail on the existing replicas, so the change
+	// is rejected instead.
+	if d.Id() != "" && d.HasChange("item") {
+		return fmt.Errorf("YDB cannot add or remove the tables of an existing async replication: " +
+			"replicate new tables with another ydb_async_replication, or replace this one explicitly with terraform apply -replace")
+	}
+	if d.Id() != "" && d.HasChange("state") {
+		if oldState, _ := d.GetChange("state"); oldState.(string) == replicationHandler.StateDone {
+			if err := d.ForceNew("state"); err != nil {
+				return err
+			}
+		}
+	}
+	if !d.GetRawConfig().GetAttr("commit_interval").IsNull() && d.Get("consistency_level").(string) != replicationHandler.ConsistencyLevelGlobal {
+		return fmt.Errorf("commit_interval requires consistency_level = %q", replicationHandler.ConsistencyLevelGlobal)
+	}
+	return nil
+}
+
+func ResourceCreateFunc(cb auth.GetAuthCallback) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		authCreds, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{Severity: diag.Error, Summary: "failed to create token for YDB request", Detail: err.Error()},
+			}
+		}
+		h := replicationHandler.NewHandler(authCreds)
+		return h.Create(ctx, d, meta)
+	}
+}
+
+func ResourceReadFunc(cb auth.GetAuthCallback) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		authCreds, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{Severity: diag.Error, Summary: "failed to create token for YDB request", Detail: err.Error()},
+			}
+		}
+		h := replicationHandler.NewHandler(authCreds)
+		return h.Read(ctx, d, meta)
+	}
+}
+
+func ResourceUpdateFunc(cb auth.GetAuthCallback) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		authCreds, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{Severity: diag.Error, Summary: "failed to create token for YDB request", Detail: err.Error()},
+			}
+		}
+		h := replicationHandler.NewHandler(authCreds)
+		return h.Update(ctx, d, meta)
+	}
+}
+
+func ResourceDeleteFunc(cb auth.GetAuthCallback) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		authCreds, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{Severity: diag.Error, Summary: "failed to create token for YDB request", Detail: err.Error()},
+			}
+		}
+		h := replicationHandler.NewHandler(authCreds)
+		return h.Delete(ctx, d, meta)
+	}
+}
@@ -0,0 +1,48 @@
+package asyncreplication
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceCustomizeDiffItems(t *testing.T) {
+	res := &schema.Resource{
+		Schema:        ResourceSchema(),
+		CustomizeDiff: ResourceCustomizeDiff,
+	}
+	state := &terraform.InstanceState{
+		ID: "grpc://localhost:2136/?database=/local?path=repl",
+		Attributes: map[string]string{
+			"connection_string":          "grpc://localhost:2136/?database=/local",
+			"path":                       "repl",
+			"source.#":                   "1",
+			"source.0.connection_string": "grpc://source:2136/?database=/source",
+			"item.#":                     "1",
+			"item.0.source_path":         "orders",
+			"item.0.target_path":         "replica/orders",
+			"consistency_level":          "Row",
+			"state":                      "RUNNING",
+			"drop_cascade":               "false",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"connection_string": "grpc://localhost:2136/?database=/local",
+		"path":              "repl",
+		"source": []interface{}{
+			map[string]interface{}{"connection_string": "grpc://source:2136/?database=/source"},
+		},
+		"item": []interface{}{
+			map[string]interface{}{"source_path": "orders", "target_path": "replica/orders"},
+			map[string]interface{}{"source_path": "items", "target_path": "replica/items"},
+		},
+	})
+
+	_, err := res.Diff(context.Background(), state, config, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot add or remove the tables of an existing async replication")
+}
@@ -2,21 +2,49 @@ package auth
 
 import (
 	"context"
+	"crypto/tls"
 
 	"github.com/ydb-platform/ydb-go-genproto/Ydb_Auth_V1"
 	"github.com/ydb-platform/ydb-go-genproto/protos/Ydb_Auth"
+	ydb "github.com/ydb-platform/ydb-go-sdk/v3"
+	"github.com/ydb-platform/ydb-go-sdk/v3/credentials"
 	"google.golang.org/grpc"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/retry"
 )
 
 type YdbCredentials struct {
 	Token    string
 	User     string
 	Password string
+	// Credentials takes precedence over Token and User/Password when set.
+	Credentials credentials.Credentials
+	// TLSConfig is used for secure (grpcs) connections when set.
+	TLSConfig *tls.Config
+	// RetryPolicy is applied to schema changes and other mutating operations.
+	RetryPolicy retry.Policy
+}
+
+// Options returns the ydb.Open options authenticating with c.
+func (c YdbCredentials) Options() []ydb.Option {
+	var opts []ydb.Option
+	if c.TLSConfig != nil {
+		opts = append(opts, ydb.WithTLSConfig(c.TLSConfig.Clone()))
+	}
+	switch {
+	case c.Credentials != nil:
+		opts = append(opts, ydb.WithCredentials(c.Credentials))
+	case c.Token != "":
+		opts = append(opts, ydb.WithAccessTokenCredentials(c.Token))
+	case c.User != "":
+		opts = append(opts, ydb.WithStaticCredentials(c.User, c.Password))
+	}
+	return opts
 }
 
 type GetAuthCallback func(ctx context.Context) (YdbCredentials, error)
 
-func GetTokenFromStaticCreds(ctx context.Context, user, password string, conn *grpc.ClientConn) (string, error) {
+func GetTokenFromStaticCreds(ctx context.Context, user, password string, conn grpc.ClientConnInterface) (string, error) {
 	request := &Ydb_Auth.LoginRequest{
 		User:     user,
 		Password: password,
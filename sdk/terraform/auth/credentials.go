@@ -0,0 +1,248 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/credentials"
+)
+
+const (
+	// DefaultMetadataURL is the token endpoint of the compute instance metadata service.
+	DefaultMetadataURL = "http://169.254.169.254/computeMetadata/v1/instance/service-accounts/default/token"
+	// DefaultIAMEndpoint exchanges signed service account JWTs for IAM tokens.
+	DefaultIAMEndpoint = "https://iam.api.cloud.yandex.net/iam/v1/tokens"
+
+	// tokenRefreshMargin is how long before expiration a cached token is refreshed.
+	tokenRefreshMargin   = time.Minute
+	serviceAccountJWTTTL = time.Hour
+	httpRequestTimeout   = 10 * time.Second
+)
+
+type tokenFileCredentials struct {
+	path string
+}
+
+// NewTokenFileCredentials returns credentials that read an access token from path. The file is
+// re-read on every call, so tokens rotated on disk are picked up without reconfiguration.
+func NewTokenFileCredentials(path string) credentials.Credentials {
+	return &tokenFileCredentials{path: path}
+}
+
+func (c *tokenFileCredentials) Token(_ context.Context) (string, error) {
+	return readTokenFile(c.path)
+}
+
+func (c *tokenFileCredentials) String() string {
+	return fmt.Sprintf("TokenFile{Path:%q}", c.path)
+}
+
+type tokenFileSource struct {
+	path      string
+	tokenType string
+}
+
+// NewTokenFileSource returns an OAuth 2.0 token exchange token source that reads the token from
+// path. Like NewTokenFileCredentials, it re-reads the file on every exchange.
+func NewTokenFileSource(path, tokenType string) credentials.TokenSource {
+	return &tokenFileSource{path: path, tokenType: tokenType}
+}
+
+func (s *tokenFileSource) Token() (credentials.Token, error) {
+	token, err := readTokenFile(s.path)
+	if err != nil {
+		return credentials.Token{}, err
+	}
+	return credentials.Token{Token: token, TokenType: s.tokenType}, nil
+}
+
+func (s *tokenFileSource) String() string {
+	return fmt.Sprintf("TokenFileSource{Path:%q,Type:%s}", s.path, s.tokenType)
+}
+
+func readTokenFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read token file: %w", err)
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("token file %q is empty", path)
+	}
+	return token, nil
+}
+
+// cachedToken caches a token until shortly before it expires.
+type cachedToken struct {
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+	fetch     func(ctx context.Context) (token string, expiresAt time.Time, err error)
+}
+
+func (c *cachedToken) Token(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.token != "" && time.Now().Add(tokenRefreshMargin).Before(c.expiresAt) {
+		return c.token, nil
+	}
+	token, expiresAt, err := c.fetch(ctx)
+	if err != nil {
+		return "", err
+	}
+	c.token, c.expiresAt = token, expiresAt
+	return c.token, nil
+}
+
+type metadataCredentials struct {
+	cachedToken
+	url string
+}
+
+// NewMetadataCredentials returns credentials that obtain tokens from the instance metadata
+// service at url (DefaultMetadataURL when empty).
+func NewMetadataCredentials(url string) credentials.Credentials {
+	if url == "" {
+		url = DefaultMetadataURL
+	}
+	c := &metadataCredentials{url: url}
+	c.fetch = c.fetchToken
+	return c
+}
+
+func (c *metadataCredentials) fetchToken(ctx context.Context) (string, time.Time, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url, http.NoBody)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	req.Header.Set("Metadata-Flavor", "Google")
+
+	var resp struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err = doJSONRequest(req, &resp); err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to get token from metadata service: %w", err)
+	}
+	if resp.AccessToken == "" {
+		return "", time.Time{}, fmt.Errorf("metadata service returned empty token")
+	}
+	return resp.AccessToken, time.Now().Add(time.Duration(resp.ExpiresIn) * time.Second), nil
+}
+
+func (c *metadataCredentials) String() string {
+	return fmt.Sprintf("Metadata{URL:%q}", c.url)
+}
+
+// ServiceAccountKey is an authorized key of a service account as stored in a key JSON file.
+type ServiceAccountKey struct {
+	ID               string `json:"id"`
+	ServiceAccountID string `json:"service_account_id"`
+	PrivateKey       string `json:"private_key"`
+}
+
+type serviceAccountKeyCredentials struct {
+	cachedToken
+	key      ServiceAccountKey
+	endpoint string
+}
+
+// NewServiceAccountKeyFileCredentials returns credentials that sign a JWT with the service account
+// key from keyFile and exchange it for an IAM token at endpoint (DefaultIAMEndpoint when empty).
+func NewServiceAccountKeyFileCredentials(keyFile, endpoint string) (credentials.Credentials, error) {
+	data, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read service account key file: %w", err)
+	}
+	var key ServiceAccountKey
+	if err = json.Unmarshal(data, &key); err != nil {
+		return nil, fmt.Errorf("failed to parse service account key file %q: %w", keyFile, err)
+	}
+	return NewServiceAccountKeyCredentials(key, endpoint)
+}
+
+// NewServiceAccountKeyCredentials is NewServiceAccountKeyFileCredentials for an already parsed key.
+func NewServiceAccountKeyCredentials(key ServiceAccountKey, endpoint string) (credentials.Credentials, error) {
+	if key.ID == "" || key.ServiceAccountID == "" || key.PrivateKey == "" {
+		return nil, fmt.Errorf("service account key must have id, service_account_id and private_key")
+	}
+	if endpoint == "" {
+		endpoint = DefaultIAMEndpoint
+	}
+	c := &serviceAccountKeyCredentials{key: key, endpoint: endpoint}
+	c.fetch = c.fetchToken
+	return c, nil
+}
+
+func (c *serviceAccountKeyCredentials) fetchToken(ctx context.Context) (string, time.Time, error) {
+	jwt, err := credentials.NewJWTTokenSource(
+		credentials.WithSigningMethodName("PS256"),
+		credentials.WithRSAPrivateKeyPEMContent([]byte(c.key.PrivateKey)),
+		credentials.WithKeyID(c.key.ID),
+		credentials.WithIssuer(c.key.ServiceAccountID),
+		credentials.WithAudience(c.endpoint),
+		credentials.WithTokenTTL(serviceAccountJWTTTL),
+	)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to create service account JWT source: %w", err)
+	}
+	signed, err := jwt.Token()
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to sign service account JWT: %w", err)
+	}
+
+	body, err := json.Marshal(map[string]string{"jwt": signed.Token})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	var resp struct {
+		IAMToken  string    `json:"iamToken"`
+		ExpiresAt time.Time `json:"expiresAt"`
+	}
+	if err = doJSONRequest(req, &resp); err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to exchange service account JWT: %w", err)
+	}
+	if resp.IAMToken == "" {
+		return "", time.Time{}, fmt.Errorf("IAM endpoint returned empty token")
+	}
+	return resp.IAMToken, resp.ExpiresAt, nil
+}
+
+func (c *serviceAccountKeyCredentials) String() string {
+	return fmt.Sprintf("ServiceAccountKey{ID:%q,ServiceAccountID:%q,Endpoint:%q}", c.key.ID, c.key.ServiceAccountID, c.endpoint)
+}
+
+func doJSONRequest(req *http.Request, out interface{}) error {
+	client := &http.Client{Timeout: httpRequestTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s: %s", resp.Status, strings.TrimSpace(string(data)))
+	}
+	return json.Unmarshal(data, out)
+}
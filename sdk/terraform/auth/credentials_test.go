@@ -0,0 +1,162 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/ydb-platform/ydb-go-sdk/v3/credentials"
+)
+
+func TestTokenFileCredentials(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	creds := NewTokenFileCredentials(path)
+
+	_, err := creds.Token(context.Background())
+	assert.Error(t, err)
+
+	require.NoError(t, os.WriteFile(path, []byte("first\n"), 0o600))
+	token, err := creds.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "first", token)
+
+	require.NoError(t, os.WriteFile(path, []byte("second"), 0o600))
+	token, err = creds.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "second", token)
+
+	require.NoError(t, os.WriteFile(path, []byte("  \n"), 0o600))
+	_, err = creds.Token(context.Background())
+	assert.Error(t, err)
+}
+
+func TestTokenFileSource(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "oidc-token")
+	source := NewTokenFileSource(path, "urn:ietf:params:oauth:token-type:jwt")
+
+	_, err := source.Token()
+	assert.Error(t, err)
+
+	require.NoError(t, os.WriteFile(path, []byte("first\n"), 0o600))
+	token, err := source.Token()
+	require.NoError(t, err)
+	assert.Equal(t, credentials.Token{Token: "first", TokenType: "urn:ietf:params:oauth:token-type:jwt"}, token)
+
+	require.NoError(t, os.WriteFile(path, []byte("second"), 0o600))
+	token, err = source.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "second", token.Token)
+}
+
+func TestMetadataCredentials(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		if r.Header.Get("Metadata-Flavor") != "Google" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		_, _ = w.Write([]byte(`{"access_token":"metadata-token","expires_in":3600,"token_type":"Bearer"}`))
+	}))
+	defer srv.Close()
+
+	creds := NewMetadataCredentials(srv.URL)
+	for i := 0; i < 3; i++ {
+		token, err := creds.Token(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "metadata-token", token)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls), "token must be cached until expiration")
+}
+
+func TestMetadataCredentialsError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "no service account", http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	_, err := NewMetadataCredentials(srv.URL).Token(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no service account")
+}
+
+func TestServiceAccountKeyFileCredentials(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: mustMarshalPKCS8(t, privateKey)})
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			JWT string `json:"jwt"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		parts := strings.Split(req.JWT, ".")
+		if len(parts) != 3 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		header, _ := base64.RawURLEncoding.DecodeString(parts[0])
+		claims, _ := base64.RawURLEncoding.DecodeString(parts[1])
+		if !strings.Contains(string(header), `"kid":"key-id"`) ||
+			!strings.Contains(string(header), `"alg":"PS256"`) ||
+			!strings.Contains(string(claims), `"iss":"sa-id"`) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"iamToken":  "iam-token",
+			"expiresAt": time.Now().Add(time.Hour).Format(time.RFC3339),
+		})
+	}))
+	defer srv.Close()
+
+	keyFile := filepath.Join(t.TempDir(), "key.json")
+	data, err := json.Marshal(ServiceAccountKey{ID: "key-id", ServiceAccountID: "sa-id", PrivateKey: string(keyPEM)})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(keyFile, data, 0o600))
+
+	creds, err := NewServiceAccountKeyFileCredentials(keyFile, srv.URL)
+	require.NoError(t, err)
+	token, err := creds.Token(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "iam-token", token)
+}
+
+func TestServiceAccountKeyCredentialsValidation(t *testing.T) {
+	_, err := NewServiceAccountKeyCredentials(ServiceAccountKey{ID: "key-id"}, "")
+	assert.Error(t, err)
+
+	_, err = NewServiceAccountKeyFileCredentials(filepath.Join(t.TempDir(), "missing.json"), "")
+	assert.Error(t, err)
+}
+
+func TestYdbCredentialsOptions(t *testing.T) {
+	assert.Empty(t, YdbCredentials{}.Options())
+	assert.Len(t, YdbCredentials{Token: "t"}.Options(), 1)
+	assert.Len(t, YdbCredentials{User: "u", Password: "p"}.Options(), 1)
+	assert.Len(t, YdbCredentials{Token: "t", Credentials: NewTokenFileCredentials("f")}.Options(), 1)
+}
+
+func mustMarshalPKCS8(t *testing.T, key *rsa.PrivateKey) []byte {
+	t.Helper()
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	return der
+}
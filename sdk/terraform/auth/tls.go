@@ -0,0 +1,71 @@
+package auth
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+)
+
+// TLSParams describes a custom TLS setup for gRPC connections to YDB.
+type TLSParams struct {
+	// CAFile and CAPEM add certificate authorities to the system pool.
+	CAFile string
+	CAPEM  string
+	// ClientCertFile and ClientKeyFile are a PEM-encoded client certificate and key for mTLS.
+	ClientCertFile string
+	ClientKeyFile  string
+	// ServerName overrides the name used to verify the server certificate.
+	ServerName         string
+	InsecureSkipVerify bool
+}
+
+// IsEmpty reports whether p leaves the default TLS setup unchanged.
+func (p TLSParams) IsEmpty() bool {
+	return p == TLSParams{}
+}
+
+// Config builds the TLS config described by p. It returns nil when p is empty, so that
+// connections keep the SDK defaults.
+func (p TLSParams) Config() (*tls.Config, error) {
+	if p.IsEmpty() {
+		return nil, nil
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+	if p.CAFile != "" {
+		data, err := os.ReadFile(p.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file: %w", err)
+		}
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("no certificates found in CA file %q", p.CAFile)
+		}
+	}
+	if p.CAPEM != "" && !pool.AppendCertsFromPEM([]byte(p.CAPEM)) {
+		return nil, fmt.Errorf("no certificates found in CA PEM")
+	}
+
+	cfg := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		RootCAs:            pool,
+		ServerName:         p.ServerName,
+		InsecureSkipVerify: p.InsecureSkipVerify, //nolint:gosec // explicit opt-in by the user
+	}
+
+	switch {
+	case p.ClientCertFile != "" && p.ClientKeyFile != "":
+		cert, err := tls.LoadX509KeyPair(p.ClientCertFile, p.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	case p.ClientCertFile != "" || p.ClientKeyFile != "":
+		return nil, fmt.Errorf("client certificate and key must be set together")
+	}
+
+	return cfg, nil
+}
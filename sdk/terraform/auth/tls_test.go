@@ -0,0 +1,110 @@
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTLSParamsEmpty(t *testing.T) {
+	cfg, err := TLSParams{}.Config()
+	require.NoError(t, err)
+	assert.Nil(t, cfg)
+}
+
+func TestTLSParamsCA(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	defer srv.Close()
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	addr := srv.Listener.Addr().String()
+
+	cfg, err := TLSParams{ServerName: "example.com"}.Config()
+	require.NoError(t, err)
+	_, err = tls.Dial("tcp", addr, cfg)
+	assert.Error(t, err, "test server certificate must not be trusted by default")
+
+	cfg, err = TLSParams{CAPEM: string(caPEM), ServerName: "example.com"}.Config()
+	require.NoError(t, err)
+	conn, err := tls.Dial("tcp", addr, cfg)
+	require.NoError(t, err)
+	_ = conn.Close()
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, caPEM, 0o600))
+	cfg, err = TLSParams{CAFile: caFile, ServerName: "example.com"}.Config()
+	require.NoError(t, err)
+	conn, err = tls.Dial("tcp", addr, cfg)
+	require.NoError(t, err)
+	_ = conn.Close()
+
+	cfg, err = TLSParams{CAPEM: string(caPEM), ServerName: "other.example.org"}.Config()
+	require.NoError(t, err)
+	_, err = tls.Dial("tcp", addr, cfg)
+	assert.Error(t, err, "server name override must be verified")
+
+	cfg, err = TLSParams{InsecureSkipVerify: true}.Config()
+	require.NoError(t, err)
+	conn, err = tls.Dial("tcp", addr, cfg)
+	require.NoError(t, err)
+	_ = conn.Close()
+}
+
+func TestTLSParamsClientCertificate(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := writeSelfSignedCert(t, dir)
+
+	cfg, err := TLSParams{ClientCertFile: certFile, ClientKeyFile: keyFile}.Config()
+	require.NoError(t, err)
+	assert.Len(t, cfg.Certificates, 1)
+
+	_, err = TLSParams{ClientCertFile: certFile}.Config()
+	assert.Error(t, err)
+
+	_, err = TLSParams{ClientCertFile: keyFile, ClientKeyFile: certFile}.Config()
+	assert.Error(t, err)
+}
+
+func TestTLSParamsInvalidCA(t *testing.T) {
+	_, err := TLSParams{CAPEM: "not a certificate"}.Config()
+	assert.Error(t, err)
+
+	_, err = TLSParams{CAFile: filepath.Join(t.TempDir(), "missing.pem")}.Config()
+	assert.Error(t, err)
+}
+
+func writeSelfSignedCert(t *testing.T, dir string) (certFile, keyFile string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile = filepath.Join(dir, "client.pem")
+	keyFile = filepath.Join(dir, "client.key")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+	return certFile, keyFile
+}
@@ -0,0 +1,95 @@
+package backupcollection
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	backupCollectionHandler "github.com/ydb-platform/terraform-provider-ydb/internal/resources/backupcollection"
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
+)
+
+func BackupResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"connection_string": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "Connection string for YDB database.",
+		},
+		"collection": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			Description:  "Name of the backup collection to back up.",
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		},
+		"incremental": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     false,
+			Description: "Run an incremental backup instead of a full one. The collection must have incremental backups enabled and a full backup.",
+		},
+		"triggers": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "Arbitrary values that run a new backup when changed.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"backup_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Name of the backup directory in the collection.",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Time the backup was taken, in RFC 3339 format.",
+		},
+	}
+}
+
+func BackupResourceCreateFunc(cb auth.GetAuthCallback) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		authCreds, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{Severity: diag.Error, Summary: "failed to create token for YDB request", Detail: err.Error()},
+			}
+		}
+		h := backupCollectionHandler.NewBackupHandler(authCreds)
+		return h.Create(ctx, d, meta)
+	}
+}
+
+func BackupResourceReadFunc(cb auth.GetAuthCallback) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		authCreds, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{Severity: diag.Error, Summary: "failed to create token for YDB request", Detail: err.Error()},
+			}
+		}
+		h := backupCollectionHandler.NewBackupHandler(authCreds)
+		return h.Read(ctx, d, meta)
+	}
+}
+
+func BackupResourceDeleteFunc(cb auth.GetAuthCallback) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		authCreds, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{Severity: diag.Error, Summary: "failed to create token for YDB request", Detail: err.Error()},
+			}
+		}
+		h := backupCollectionHandler.NewBackupHandler(authCreds)
+		return h.Delete(ctx, d, meta)
+	}
+}
@@ -0,0 +1,71 @@
+package backupcollection
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	backupCollectionHandler "github.com/ydb-platform/terraform-provider-ydb/internal/resources/backupcollection"
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
+)
+
+func HistoryDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"connection_string": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Connection string for YDB database.",
+		},
+		"collection": {
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "Name of the backup collection.",
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		},
+		"backups": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Backups of the collection, oldest first.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Name of the backup directory in the collection.",
+					},
+					"type": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Backup type: `full` or `incremental`.",
+					},
+					"created_at": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Time the backup was taken, in RFC 3339 format.",
+					},
+				},
+			},
+		},
+		"last_full_backup": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Name of the latest full backup, empty if there is none.",
+		},
+	}
+}
+
+func DataSourceHistoryReadFunc(cb auth.GetAuthCallback) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+		authCreds, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{Severity: diag.Error, Summary: "failed to create token for YDB request", Detail: err.Error()},
+			}
+		}
+		return backupCollectionHandler.ReadHistory(ctx, d, authCreds)
+	}
+}
@@ -0,0 +1,91 @@
+package directory
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	directoryHandler "github.com/ydb-platform/terraform-provider-ydb/internal/resources/directory"
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
+)
+
+func EntriesDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"connection_string": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Connection string for YDB database.",
+		},
+		"path": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Directory to list, relative to the database root or absolute. Defaults to the database root.",
+		},
+		"recursive": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "List entries of subdirectories too.",
+		},
+		"types": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Return only entries of these types, e.g. `table`, `topic` or `directory`. All entries are returned when empty.",
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice(directoryHandler.EntryTypeNames(), false),
+			},
+		},
+		"entries": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Found entries.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Entry name.",
+					},
+					"path": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Entry path relative to the database root.",
+					},
+					"type": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Entry type.",
+					},
+					"owner": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Entry owner.",
+					},
+				},
+			},
+		},
+		"paths": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Paths of the found entries relative to the database root, in the same order as `entries`.",
+		},
+	}
+}
+
+func DataSourceEntriesReadFunc(cb auth.GetAuthCallback) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+		authCreds, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{Severity: diag.Error, Summary: "failed to create token for YDB request", Detail: err.Error()},
+			}
+		}
+		return directoryHandler.ReadEntries(ctx, d, authCreds)
+	}
+}
@@ -0,0 +1,122 @@
+package directory
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	directoryHandler "github.com/ydb-platform/terraform-provider-ydb/internal/resources/directory"
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
+)
+
+func ResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"connection_string": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "Connection string for YDB database.",
+		},
+		"path": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateFunc:     validation.NoZeroValues,
+			DiffSuppressFunc: directoryHandler.SuppressPathDiff,
+			Description:      "Directory path relative to the database root or absolute.",
+		},
+		"recursive": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Create missing parent directories. When false, the parent directory must already exist.",
+		},
+		"remove_only_if_empty": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "On destroy, fail and keep the directory if it still has children. When false, the directory is removed together with everything inside it, including tables, topics and other objects not managed by Terraform.",
+		},
+		"inherit_permissions": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Whether the directory inherits permissions of its parent. YDB does not return this flag in Describe, so the value in state is taken from configuration only.",
+		},
+		"full_path": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Absolute catalog path of the directory (database path plus `path`).",
+		},
+		"owner": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Owner of the directory.",
+		},
+	}
+}
+
+func ResourceCreateFunc(cb auth.GetAuthCallback) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		authCreds, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{Severity: diag.Error, Summary: "failed to create token for YDB request", Detail: err.Error()},
+			}
+		}
+		h := directoryHandler.NewHandler(authCreds)
+		return h.Create(ctx, d, meta)
+	}
+}
+
+func ResourceReadFunc(cb auth.GetAuthCallback) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		authCreds, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{Severity: diag.Error, Summary: "failed to create token for YDB request", Detail: err.Error()},
+			}
+		}
+		h := directoryHandler.NewHandler(authCreds)
+		return h.Read(ctx, d, meta)
+	}
+}
+
+func ResourceUpdateFunc(cb auth.GetAuthCallback) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		authCreds, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{Severity: diag.Error, Summary: "failed to create token for YDB request", Detail: err.Error()},
+			}
+		}
+		h := directoryHandler.NewHandler(authCreds)
+		return h.Update(ctx, d, meta)
+	}
+}
+
+func ResourceDeleteFunc(cb auth.GetAuthCallback) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		authCreds, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{Severity: diag.Error, Summary: "failed to create token for YDB request", Detail: err.Error()},
+			}
+		}
+		h := directoryHandler.NewHandler(authCreds)
+		return h.Delete(ctx, d, meta)
+	}
+}
+
+// ImportState fills arguments with defaults that Read cannot restore, so that an imported
+// directory is not destroyed recursively before the next apply.
+func ImportState(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	_ = d.Set("recursive", true)
+	_ = d.Set("remove_only_if_empty", true)
+	_ = d.Set("inherit_permissions", true)
+	return []*schema.ResourceData{d}, nil
+}
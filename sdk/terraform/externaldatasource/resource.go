@@ -104,7 +104,8 @@ func ResourceSchema() map[string]*schema.Schema {
 		"connection_string": {
 			Type:        schema.TypeString,
 			Description: "Connection string for the database.",
-			Required:    true,
+			Optional:    true,
+			Computed:    true,
 			ForceNew:    true,
 		},
 		"path": {
@@ -251,7 +252,8 @@ func DataSourceSchema() map[string]*schema.Schema {
 		"connection_string": {
 			Type:        schema.TypeString,
 			Description: "Connection string for the database.",
-			Required:    true,
+			Optional:    true,
+			Computed:    true,
 		},
 		"path": {
 			Type:        schema.TypeString,
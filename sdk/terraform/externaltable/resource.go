@@ -38,6 +38,19 @@ func ResourceReadFunc(cb auth.GetAuthCallback) helpers.TerraformCRUD {
 	}
 }
 
+func ResourceUpdateFunc(cb auth.GetAuthCallback) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		authCreds, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{Severity: diag.Error, Summary: "failed to create token for YDB request", Detail: err.Error()},
+			}
+		}
+		h := externaltable.NewHandler(authCreds)
+		return h.Update(ctx, d, meta)
+	}
+}
+
 func ResourceDeleteFunc(cb auth.GetAuthCallback) helpers.TerraformCRUD {
 	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		authCreds, err := cb(ctx)
@@ -78,7 +91,8 @@ func ResourceSchema() map[string]*schema.Schema {
 		"connection_string": {
 			Type:        schema.TypeString,
 			Description: "Connection string for the database.",
-			Required:    true,
+			Optional:    true,
+			Computed:    true,
 			ForceNew:    true,
 		},
 		"path": {
@@ -92,7 +106,6 @@ func ResourceSchema() map[string]*schema.Schema {
 			Type:        schema.TypeList,
 			Description: "A list of column definitions.",
 			Required:    true,
-			ForceNew:    true,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"name": {
@@ -122,26 +135,22 @@ func ResourceSchema() map[string]*schema.Schema {
 			Type:        schema.TypeString,
 			Description: "Name of the external data source.",
 			Required:    true,
-			ForceNew:    true,
 		},
 		"location": {
 			Type:        schema.TypeString,
 			Description: "Path within the external data source.",
 			Required:    true,
-			ForceNew:    true,
 		},
 		"format": {
 			Type:             schema.TypeString,
 			Description:      "Data format (csv_with_names, tsv_with_names, json_list, json_each_row, parquet, raw).",
 			Required:         true,
-			ForceNew:         true,
 			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(externalTableFormats, false)),
 		},
 		"compression": {
 			Type:        schema.TypeString,
 			Description: "Compression algorithm (e.g. gzip).",
 			Optional:    true,
-			ForceNew:    true,
 		},
 	}
 }
@@ -151,7 +160,8 @@ func DataSourceSchema() map[string]*schema.Schema {
 		"connection_string": {
 			Type:        schema.TypeString,
 			Description: "Connection string for the database.",
-			Required:    true,
+			Optional:    true,
+			Computed:    true,
 		},
 		"path": {
 			Type:        schema.TypeString,
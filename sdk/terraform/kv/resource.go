@@ -17,7 +17,8 @@ func ResourceSchema() map[string]*schema.Schema {
 		"connection_string": {
 			Type:     schema.TypeString,
 			ForceNew: true,
-			Required: true,
+			Optional: true,
+			Computed: true,
 		},
 		"path": {
 			Type:         schema.TypeString,
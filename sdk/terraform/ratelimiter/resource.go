@@ -94,7 +94,8 @@ func ResourceSchema() map[string]*schema.Schema {
 		"connection_string": {
 			Type:     schema.TypeString,
 			ForceNew: true,
-			Required: true,
+			Optional: true,
+			Computed: true,
 		},
 		"resource_path": {
 			Type:     schema.TypeString,
@@ -0,0 +1,153 @@
+package resourcepool
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	poolHandler "github.com/ydb-platform/terraform-provider-ydb/internal/resources/resourcepool"
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
+)
+
+func ResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"connection_string": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "Connection string for YDB database.",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Resource pool name.",
+		},
+		"concurrent_query_limit": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      poolHandler.NoLimit,
+			Description:  "Maximum number of queries executed in the pool at the same time, from 0 to 1000. -1 means no limit.",
+			ValidateFunc: validateLimit(0, 1000),
+		},
+		"queue_size": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      poolHandler.NoLimit,
+			Description:  "Maximum number of queries waiting for execution when `concurrent_query_limit` is reached. -1 means no limit.",
+			ValidateFunc: validateLimit(0, -1),
+		},
+		"database_load_cpu_threshold": {
+			Type:         schema.TypeFloat,
+			Optional:     true,
+			Default:      float64(poolHandler.NoLimit),
+			Description:  "Database CPU load in percent above which new queries of the pool are queued. -1 disables the threshold.",
+			ValidateFunc: validateLimit(0, 100),
+		},
+		"resource_weight": {
+			Type:         schema.TypeFloat,
+			Optional:     true,
+			Default:      float64(poolHandler.NoLimit),
+			Description:  "Weight of the pool when CPU is shared fairly between pools. -1 disables fair sharing for the pool.",
+			ValidateFunc: validateLimit(0, -1),
+		},
+		"total_cpu_limit_percent_per_node": {
+			Type:         schema.TypeFloat,
+			Optional:     true,
+			Default:      float64(poolHandler.NoLimit),
+			Description:  "CPU limit of all queries of the pool on a node, in percent. -1 means no limit.",
+			ValidateFunc: validateLimit(0, 100),
+		},
+		"query_cpu_limit_percent_per_node": {
+			Type:         schema.TypeFloat,
+			Optional:     true,
+			Default:      float64(poolHandler.NoLimit),
+			Description:  "CPU limit of a single query of the pool on a node, in percent. -1 means no limit.",
+			ValidateFunc: validateLimit(0, 100),
+		},
+		"query_memory_limit_percent_per_node": {
+			Type:         schema.TypeFloat,
+			Optional:     true,
+			Default:      float64(poolHandler.NoLimit),
+			Description:  "Memory limit of a single query of the pool on a node, in percent. -1 means no limit.",
+			ValidateFunc: validateLimit(0, 100),
+		},
+	}
+}
+
+// validateLimit accepts -1 (no limit) or a value from minValue to maxValue. A negative maxValue
+// means there is no upper bound.
+func validateLimit(minValue, maxValue float64) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) ([]string, []error) {
+		var value float64
+		switch v := v.(type) {
+		case int:
+			value = float64(v)
+		case float64:
+			value = v
+		}
+		if value == poolHandler.NoLimit || value >= minValue && (maxValue < 0 || value <= maxValue) {
+			return nil, nil
+		}
+		if maxValue < 0 {
+			return nil, []error{fmt.Errorf("expected %s to be -1 (no limit) or at least %v, got %v", k, minValue, value)}
+		}
+		return nil, []error{fmt.Errorf("expected %s to be -1 (no limit) or in the range (%v - %v), got %v", k, minValue, maxValue, value)}
+	}
+}
+
+func ResourceCreateFunc(cb auth.GetAuthCallback) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		authCreds, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{Severity: diag.Error, Summary: "failed to create token for YDB request", Detail: err.Error()},
+			}
+		}
+		h := poolHandler.NewHandler(authCreds)
+		return h.Create(ctx, d, meta)
+	}
+}
+
+func ResourceReadFunc(cb auth.GetAuthCallback) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		authCreds, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{Severity: diag.Error, Summary: "failed to create token for YDB request", Detail: err.Error()},
+			}
+		}
+		h := poolHandler.NewHandler(authCreds)
+		return h.Read(ctx, d, meta)
+	}
+}
+
+func ResourceUpdateFunc(cb auth.GetAuthCallback) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		authCreds, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{Severity: diag.Error, Summary: "failed to create token for YDB request", Detail: err.Error()},
+			}
+		}
+		h := poolHandler.NewHandler(authCreds)
+		return h.Update(ctx, d, meta)
+	}
+}
+
+func ResourceDeleteFunc(cb auth.GetAuthCallback) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		authCreds, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{Severity: diag.Error, Summary: "failed to create token for YDB request", Detail: err.Error()},
+			}
+		}
+		h := poolHandler.NewHandler(authCreds)
+		return h.Delete(ctx, d, meta)
+	}
+}
@@ -0,0 +1,25 @@
+package resourcepool
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateLimit(t *testing.T) {
+	percent := validateLimit(0, 100)
+	for _, v := range []interface{}{-1, -1.0, 0, 55.5, 100} {
+		_, errs := percent(v, "total_cpu_limit_percent_per_node")
+		assert.Empty(t, errs, "value %v", v)
+	}
+	for _, v := range []interface{}{-2, -0.5, 100.1, 1000} {
+		_, errs := percent(v, "total_cpu_limit_percent_per_node")
+		assert.Len(t, errs, 1, "value %v", v)
+	}
+
+	unbounded := validateLimit(0, -1)
+	_, errs := unbounded(100000, "queue_size")
+	assert.Empty(t, errs)
+	_, errs = unbounded(-3, "queue_size")
+	assert.EqualError(t, errs[0], "expected queue_size to be -1 (no limit) or at least 0, got -3")
+}
@@ -2,40 +2,21 @@ package secret
 
 import (
 	"context"
-	"encoding/base64"
-	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"golang.org/x/crypto/scrypt"
 
 	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
 	secretHandler "github.com/ydb-platform/terraform-provider-ydb/internal/resources/secret"
 	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
 )
 
-// hashSecretValue hashes the secret using scrypt so the plaintext is not stored in Terraform state.
-// See rationale for scrypt choice:
-// https://github.com/yandex-cloud/terraform-provider-yandex/blob/master/yandex/resource_yandex_lockbox_secret_version_hashed.go#L121-L128
-func hashSecretValue(v interface{}) string {
-	value := v.(string)
-	if value == "" {
-		return ""
-	}
-	salt := []byte("|82&pvyYC[el3Z([,En#1:£!VJ2fKz")
-	hash, err := scrypt.Key([]byte(value), salt, 32768, 8, 1, 128)
-	if err != nil {
-		log.Printf("[ERROR] could not hash secret value: %v", err)
-		return ""
-	}
-	return base64.StdEncoding.EncodeToString(hash)
-}
-
 func ResourceSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"connection_string": {
 			Type:        schema.TypeString,
-			Required:    true,
+			Optional:    true,
+			Computed:    true,
 			ForceNew:    true,
 			Description: "Connection string for YDB database.",
 		},
@@ -54,7 +35,7 @@ func ResourceSchema() map[string]*schema.Schema {
 			Type:          schema.TypeString,
 			Optional:      true,
 			Sensitive:     true,
-			StateFunc:     hashSecretValue,
+			StateFunc:     helpers.HashSensitiveValue,
 			Description:   "Secret value. This value is sensitive and will not be displayed in plan output. Mutually exclusive with `command`.",
 			ExactlyOneOf:  []string{"value", "command"},
 			ConflictsWith: []string{"command"},
@@ -66,27 +47,7 @@ func ResourceSchema() map[string]*schema.Schema {
 			Description:   "Command to execute to generate the secret value. The command's stdout is used as the value. Mutually exclusive with `value`.",
 			ExactlyOneOf:  []string{"value", "command"},
 			ConflictsWith: []string{"value"},
-			Elem: &schema.Resource{
-				Schema: map[string]*schema.Schema{
-					"path": {
-						Type:        schema.TypeString,
-						Required:    true,
-						Description: "Path to the executable.",
-					},
-					"args": {
-						Type:        schema.TypeList,
-						Optional:    true,
-						Description: "Arguments to pass to the command.",
-						Elem:        &schema.Schema{Type: schema.TypeString},
-					},
-					"env": {
-						Type:        schema.TypeMap,
-						Optional:    true,
-						Description: "Environment variables to set for the command.",
-						Elem:        &schema.Schema{Type: schema.TypeString},
-					},
-				},
-			},
+			Elem:          helpers.CommandResource(),
 		},
 		"inherit_permissions": {
 			Type:        schema.TypeBool,
@@ -102,7 +63,8 @@ func DataSourceSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"connection_string": {
 			Type:        schema.TypeString,
-			Required:    true,
+			Optional:    true,
+			Computed:    true,
 			Description: "Connection string for YDB database.",
 		},
 		"name": {
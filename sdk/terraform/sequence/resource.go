@@ -0,0 +1,138 @@
+package sequence
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	sequenceHandler "github.com/ydb-platform/terraform-provider-ydb/internal/resources/sequence"
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
+)
+
+func ResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"connection_string": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "Connection string for YDB database.",
+		},
+		"table_path": {
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "Path of the table with the serial column, relative to the database root. A change, e.g. after the table is renamed, moves the resource to the sequence of the table at the new path.",
+			ValidateFunc: helpers.YdbTablePathCheck,
+		},
+		"column": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			Description:  "Name of the serial column the sequence generates values for.",
+			ValidateFunc: validation.NoZeroValues,
+		},
+		"start_with": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Start value of the sequence, used by RESTART without a value.",
+		},
+		"increment": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Step between generated values, may be negative.",
+			ValidateFunc: validation.IntNotInSlice([]int{0}),
+		},
+		"restart_with": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Value the sequence is restarted with. The sequence is restarted on creation of the resource while the table is empty and every time the value changes.",
+		},
+		"min_value": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Minimum value of the sequence.",
+		},
+		"max_value": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Maximum value of the sequence.",
+		},
+		"cache": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Number of values preallocated by the sequence.",
+		},
+		"cycle": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the sequence wraps around after reaching its limit.",
+		},
+		"full_path": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Absolute path of the sequence.",
+		},
+	}
+}
+
+// ResourceCustomizeDiff plans the move of the sequence when its table changes.
+func ResourceCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	return sequenceHandler.ValidateResourceDiffTableMove(d)
+}
+
+func ResourceCreateFunc(cb auth.GetAuthCallback) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		authCreds, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{Severity: diag.Error, Summary: "failed to create token for YDB request", Detail: err.Error()},
+			}
+		}
+		h := sequenceHandler.NewHandler(authCreds)
+		return h.Create(ctx, d, meta)
+	}
+}
+
+func ResourceReadFunc(cb auth.GetAuthCallback) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		authCreds, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{Severity: diag.Error, Summary: "failed to create token for YDB request", Detail: err.Error()},
+			}
+		}
+		h := sequenceHandler.NewHandler(authCreds)
+		return h.Read(ctx, d, meta)
+	}
+}
+
+func ResourceUpdateFunc(cb auth.GetAuthCallback) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		authCreds, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{Severity: diag.Error, Summary: "failed to create token for YDB request", Detail: err.Error()},
+			}
+		}
+		h := sequenceHandler.NewHandler(authCreds)
+		return h.Update(ctx, d, meta)
+	}
+}
+
+func ResourceDeleteFunc(cb auth.GetAuthCallback) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		authCreds, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{Severity: diag.Error, Summary: "failed to create token for YDB request", Detail: err.Error()},
+			}
+		}
+		h := sequenceHandler.NewHandler(authCreds)
+		return h.Delete(ctx, d, meta)
+	}
+}
@@ -0,0 +1,45 @@
+package sequence
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceCustomizeDiffTableMove(t *testing.T) {
+	res := &schema.Resource{
+		Schema:        ResourceSchema(),
+		CustomizeDiff: ResourceCustomizeDiff,
+	}
+	state := &terraform.InstanceState{
+		ID: "grpc://localhost:2136/?database=/local?path=shop/orders/_serial_column_id",
+		Attributes: map[string]string{
+			"connection_string": "grpc://localhost:2136/?database=/local",
+			"table_path":        "shop/orders",
+			"column":            "id",
+			"start_with":        "1000",
+			"increment":         "1",
+			"restart_with":      "1000",
+			"full_path":         "/local/shop/orders/_serial_column_id",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"connection_string": "grpc://localhost:2136/?database=/local",
+		"table_path":        "shop/orders_v2",
+		"column":            "id",
+		"start_with":        1000,
+		"increment":         1,
+		"restart_with":      1000,
+	})
+
+	diff, err := res.Diff(context.Background(), state, config, nil)
+	require.NoError(t, err)
+	require.NotNil(t, diff)
+	assert.False(t, diff.RequiresNew(), "a table move keeps the sequence")
+	assert.True(t, diff.Attributes["full_path"].NewComputed)
+	assert.NotContains(t, diff.Attributes, "restart_with", "a table move does not restart the sequence")
+}
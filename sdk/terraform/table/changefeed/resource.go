@@ -103,6 +103,15 @@ func ResourceImportFunc(ctx context.Context, d *schema.ResourceData, m interface
 	return []*schema.ResourceData{d}, nil
 }
 
+// ResourceCustomizeDiff moves the changefeed in place when the table it belongs to changes, e.g.
+// when the table is renamed.
+func ResourceCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	return helpers.PlanTableMove(d)
+}
+
 func ResourceSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"table_path": {
@@ -111,7 +120,6 @@ func ResourceSchema() map[string]*schema.Schema {
 			Optional:     true,
 			Computed:     true,
 			ValidateFunc: validation.All(validation.NoZeroValues, helpers.YdbTablePathCheck),
-			ForceNew:     true,
 			ConflictsWith: []string{
 				"table_id",
 			},
@@ -131,7 +139,6 @@ func ResourceSchema() map[string]*schema.Schema {
 			Type:        schema.TypeString,
 			Description: "Terraform resource ID of the table.",
 			Optional:    true,
-			ForceNew:    true,
 			Computed:    true,
 			ConflictsWith: []string{
 				"table_path",
@@ -6,9 +6,70 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/ydb-platform/terraform-provider-ydb/internal/resources/table"
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
 )
 
-// CustomizeDiff rejects unsupported column schema changes at plan time.
+// CustomizeDiff rejects unsupported column schema changes, malformed partition keys, invalid TTL
+// settings, column families and read replicas, and misplaced tablestore tables at plan time. It
+// also decides whether a primary key, store or partitioning change recreates the table or
+// migrates it, whether a path change renames it, and plans the columns to drop.
 func CustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
-	return table.ValidateResourceDiffColumns(d)
+	if err := table.ValidateResourceDiffRename(d); err != nil {
+		return err
+	}
+	if err := table.ValidateResourceDiffMigration(d); err != nil {
+		return err
+	}
+	if err := table.ValidateResourceDiffColumns(d); err != nil {
+		return err
+	}
+	if err := table.ValidateResourceDiffPartitionAtKeys(d); err != nil {
+		return err
+	}
+	if err := table.ValidateResourceDiffTTL(d); err != nil {
+		return err
+	}
+	if err := table.ValidateResourceDiffFamilies(d); err != nil {
+		return err
+	}
+	if err := table.ValidateResourceDiffReadReplicas(d); err != nil {
+		return err
+	}
+	return table.ValidateResourceDiffTablestore(d)
+}
+
+// TablestoreSchemaDiffFunc checks at plan time that a table placed in an existing tablestore
+// inherits its columns and primary key.
+func TablestoreSchemaDiffFunc(cb auth.GetAuthCallback) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, _ interface{}) error {
+		authCreds, err := cb(ctx)
+		if err != nil {
+			return err
+		}
+		return table.ValidateTablestoreSchema(ctx, d, authCreds)
+	}
+}
+
+// IndexedColumnDropDiffFunc checks at plan time that the columns dropped from an existing table
+// are not used by its secondary indexes.
+func IndexedColumnDropDiffFunc(cb auth.GetAuthCallback) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, _ interface{}) error {
+		authCreds, err := cb(ctx)
+		if err != nil {
+			return err
+		}
+		return table.ValidateIndexedColumnDrops(ctx, d, authCreds)
+	}
+}
+
+// MigrationDependentsDiffFunc checks at plan time that a table about to be migrated with the copy
+// strategy has no serial columns, secondary indexes, changefeeds or explicit permissions.
+func MigrationDependentsDiffFunc(cb auth.GetAuthCallback) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, _ interface{}) error {
+		authCreds, err := cb(ctx)
+		if err != nil {
+			return err
+		}
+		return table.ValidateMigrationDependents(ctx, d, authCreds)
+	}
 }
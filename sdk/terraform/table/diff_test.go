@@ -0,0 +1,281 @@
+package table
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomizeDiffMigrationStrategy(t *testing.T) {
+	res := &schema.Resource{
+		Schema:        ResourceSchema(),
+		CustomizeDiff: CustomizeDiff,
+	}
+	state := &terraform.InstanceState{
+		ID: "grpc://localhost:2136/?database=/local?path=t",
+		Attributes: map[string]string{
+			"id":                "grpc://localhost:2136/?database=/local?path=t",
+			"path":              "t",
+			"connection_string": "grpc://localhost:2136/?database=/local",
+			"column.#":          "2",
+			"column.1.name":     "a",
+			"column.1.type":     "Uint64",
+			"column.2.name":     "b",
+			"column.2.type":     "Utf8",
+			"primary_key.#":     "1",
+			"primary_key.0":     "a",
+		},
+	}
+	config := func(strategy string) *terraform.ResourceConfig {
+		raw := map[string]interface{}{
+			"path":              "t",
+			"connection_string": "grpc://localhost:2136/?database=/local",
+			"column": []interface{}{
+				map[string]interface{}{"name": "a", "type": "Uint64"},
+				map[string]interface{}{"name": "b", "type": "Utf8"},
+			},
+			"primary_key": []interface{}{"a", "b"},
+		}
+		if strategy != "" {
+			raw["migration_strategy"] = strategy
+		}
+		return terraform.NewResourceConfigRaw(raw)
+	}
+
+	diff, err := res.Diff(context.Background(), state, config(""), nil)
+	require.NoError(t, err)
+	assert.True(t, diff.RequiresNew(), "a primary key change recreates the table by default")
+
+	diff, err = res.Diff(context.Background(), state, config("copy"), nil)
+	require.NoError(t, err)
+	assert.False(t, diff.RequiresNew(), "a primary key change migrates the table with the copy strategy")
+	assert.True(t, diff.Attributes["migration_backups.#"].NewComputed)
+}
+
+func TestCustomizeDiffColumnDrop(t *testing.T) {
+	res := &schema.Resource{
+		Schema:        ResourceSchema(),
+		CustomizeDiff: CustomizeDiff,
+	}
+	state := &terraform.InstanceState{
+		ID: "grpc://localhost:2136/?database=/local?path=t",
+		Attributes: map[string]string{
+			"id":                "grpc://localhost:2136/?database=/local?path=t",
+			"path":              "t",
+			"connection_string": "grpc://localhost:2136/?database=/local",
+			"column.#":          "2",
+			"column.1.name":     "a",
+			"column.1.type":     "Uint64",
+			"column.2.name":     "b",
+			"column.2.type":     "Utf8",
+			"primary_key.#":     "1",
+			"primary_key.0":     "a",
+		},
+	}
+	config := func(column, typ string, allowDrop bool) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"path":              "t",
+			"connection_string": "grpc://localhost:2136/?database=/local",
+			"column": []interface{}{
+				map[string]interface{}{"name": column, "type": typ},
+			},
+			"primary_key":       []interface{}{"a"},
+			"allow_column_drop": allowDrop,
+		})
+	}
+
+	_, err := res.Diff(context.Background(), state, config("a", "Uint64", false), nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "allow_column_drop")
+
+	diff, err := res.Diff(context.Background(), state, config("a", "Uint64", true), nil)
+	require.NoError(t, err)
+	assert.False(t, diff.RequiresNew())
+	assert.Equal(t, "1", diff.Attributes["dropped_columns.#"].New)
+	assert.Equal(t, "b", diff.Attributes["dropped_columns.0"].New)
+
+	_, err = res.Diff(context.Background(), state, config("b", "Utf8", true), nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `column "a" is a primary key column`)
+}
+
+func TestCustomizeDiffRename(t *testing.T) {
+	res := &schema.Resource{
+		Schema:        ResourceSchema(),
+		CustomizeDiff: CustomizeDiff,
+	}
+	state := &terraform.InstanceState{
+		ID: "grpc://localhost:2136/?database=/local?path=t",
+		Attributes: map[string]string{
+			"id":                "grpc://localhost:2136/?database=/local?path=t",
+			"path":              "t",
+			"connection_string": "grpc://localhost:2136/?database=/local",
+			"column.#":          "1",
+			"column.1.name":     "a",
+			"column.1.type":     "Uint64",
+			"primary_key.#":     "1",
+			"primary_key.0":     "a",
+		},
+	}
+	config := func(allowRename bool) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"path":              "dir/t",
+			"connection_string": "grpc://localhost:2136/?database=/local",
+			"column": []interface{}{
+				map[string]interface{}{"name": "a", "type": "Uint64"},
+			},
+			"primary_key":  []interface{}{"a"},
+			"allow_rename": allowRename,
+		})
+	}
+
+	diff, err := res.Diff(context.Background(), state, config(false), nil)
+	require.NoError(t, err)
+	assert.True(t, diff.RequiresNew(), "a path change recreates the table by default")
+
+	diff, err = res.Diff(context.Background(), state, config(true), nil)
+	require.NoError(t, err)
+	assert.False(t, diff.RequiresNew(), "a path change renames the table with allow_rename")
+	assert.True(t, diff.Attributes["id"].NewComputed)
+}
+
+func TestCustomizeDiffReadReplicas(t *testing.T) {
+	res := &schema.Resource{
+		Schema:        ResourceSchema(),
+		CustomizeDiff: CustomizeDiff,
+	}
+	state := &terraform.InstanceState{
+		ID: "grpc://localhost:2136/?database=/local?path=t",
+		Attributes: map[string]string{
+			"id":                     "grpc://localhost:2136/?database=/local?path=t",
+			"path":                   "t",
+			"connection_string":      "grpc://localhost:2136/?database=/local",
+			"column.#":               "1",
+			"column.1.name":          "a",
+			"column.1.type":          "Uint64",
+			"primary_key.#":          "1",
+			"primary_key.0":          "a",
+			"read_replicas.#":        "1",
+			"read_replicas.0.mode":   "PER_AZ",
+			"read_replicas.0.count":  "1",
+			"read_replicas_settings": "PER_AZ:1",
+		},
+	}
+	config := func(extra map[string]interface{}) *terraform.ResourceConfig {
+		raw := map[string]interface{}{
+			"path":              "t",
+			"connection_string": "grpc://localhost:2136/?database=/local",
+			"column": []interface{}{
+				map[string]interface{}{"name": "a", "type": "Uint64"},
+			},
+			"primary_key": []interface{}{"a"},
+		}
+		for k, v := range extra {
+			raw[k] = v
+		}
+		return terraform.NewResourceConfigRaw(raw)
+	}
+
+	diff, err := res.Diff(context.Background(), state, config(map[string]interface{}{
+		"read_replicas_settings": "per_az:1",
+	}), nil)
+	require.NoError(t, err)
+	assert.NotContains(t, diff.Attributes, "read_replicas.#", "the same settings spelled differently are not a change")
+	assert.NotContains(t, diff.Attributes, "read_replicas_settings")
+
+	diff, err = res.Diff(context.Background(), state, config(map[string]interface{}{
+		"read_replicas": []interface{}{
+			map[string]interface{}{"mode": "ANY_AZ", "count": 2},
+		},
+	}), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "ANY_AZ", diff.Attributes["read_replicas.0.mode"].New)
+	assert.True(t, diff.Attributes["read_replicas_settings"].NewComputed)
+
+	_, err = res.Diff(context.Background(), nil, config(map[string]interface{}{
+		"store": "column",
+		"read_replicas": []interface{}{
+			map[string]interface{}{"mode": "ANY_AZ", "count": 2},
+		},
+	}), nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "row tables only")
+}
+
+func TestCustomizeDiffPartitionAtKeys(t *testing.T) {
+	res := &schema.Resource{
+		Schema:        ResourceSchema(),
+		CustomizeDiff: CustomizeDiff,
+	}
+	state := &terraform.InstanceState{
+		ID: "grpc://localhost:2136/?database=/local?path=t",
+		Attributes: map[string]string{
+			"id":                      "grpc://localhost:2136/?database=/local?path=t",
+			"path":                    "t",
+			"connection_string":       "grpc://localhost:2136/?database=/local",
+			"column.#":                "2",
+			"column.1.name":           "ts",
+			"column.1.type":           "Timestamp",
+			"column.2.name":           "id",
+			"column.2.type":           "Uint64",
+			"primary_key.#":           "2",
+			"primary_key.0":           "ts",
+			"primary_key.1":           "id",
+			"partitioning_settings.#": "1",
+			"partitioning_settings.0.partition_at_keys.#":               "1",
+			"partitioning_settings.0.partition_at_keys.0.keys.#":        "1",
+			"partitioning_settings.0.partition_at_keys.0.keys.0":        "2024-01-01T00:00:00Z",
+			"partitioning_settings.0.auto_partitioning_by_load":         "false",
+			"partitioning_settings.0.auto_partitioning_by_size_enabled": "false",
+		},
+	}
+	config := func(strategy string, keys ...interface{}) *terraform.ResourceConfig {
+		raw := map[string]interface{}{
+			"path":              "t",
+			"connection_string": "grpc://localhost:2136/?database=/local",
+			"column": []interface{}{
+				map[string]interface{}{"name": "ts", "type": "Timestamp"},
+				map[string]interface{}{"name": "id", "type": "Uint64"},
+			},
+			"primary_key": []interface{}{"ts", "id"},
+			"partitioning_settings": []interface{}{
+				map[string]interface{}{
+					"auto_partitioning_by_load":         false,
+					"auto_partitioning_by_size_enabled": false,
+					"partition_at_keys": []interface{}{
+						map[string]interface{}{"keys": keys},
+					},
+				},
+			},
+		}
+		if strategy != "" {
+			raw["migration_strategy"] = strategy
+		}
+		return terraform.NewResourceConfigRaw(raw)
+	}
+
+	_, err := res.Diff(context.Background(), nil, config("", "2024-01-01"), nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `partition_at_keys[0].keys[0] "2024-01-01" for column "ts" of type Timestamp`)
+
+	diff, err := res.Diff(context.Background(), state, config("", "2024-01-01T00:00:00Z"), nil)
+	require.NoError(t, err)
+	assert.False(t, diff.RequiresNew())
+	assert.NotContains(t, diff.Attributes, "partitioning_settings.0.partition_at_keys.0.keys.#")
+
+	_, err = res.Diff(context.Background(), state, config("", "2024-01-01T00:00:00Z", "10"), nil)
+	require.Error(t, err, "split points are never changed by recreating the table")
+	assert.Contains(t, err.Error(), `can only be changed with migration_strategy = "copy"`)
+
+	_, err = res.Diff(context.Background(), state, config("", "2024-02-01T00:00:00Z"), nil)
+	require.Error(t, err, "a changed split point value is rejected as well")
+
+	diff, err = res.Diff(context.Background(), state, config("copy", "2024-02-01T00:00:00Z"), nil)
+	require.NoError(t, err)
+	assert.False(t, diff.RequiresNew(), "the copy strategy migrates the table to the new split points")
+	assert.True(t, diff.Attributes["migration_backups.#"].NewComputed)
+}
@@ -89,7 +89,6 @@ func ResourceSchema() map[string]*schema.Schema {
 		"table_path": {
 			Type:         schema.TypeString,
 			Optional:     true,
-			ForceNew:     true,
 			Computed:     true,
 			ValidateFunc: validation.All(validation.NoZeroValues, helpers.YdbTablePathCheck),
 			ConflictsWith: []string{
@@ -109,7 +108,6 @@ func ResourceSchema() map[string]*schema.Schema {
 		"table_id": {
 			Type:     schema.TypeString,
 			Optional: true,
-			ForceNew: true,
 			Computed: true,
 			ConflictsWith: []string{
 				"table_path",
@@ -126,25 +124,52 @@ func ResourceSchema() map[string]*schema.Schema {
 			Type:         schema.TypeString,
 			Required:     true,
 			ValidateFunc: validation.NoZeroValues,
-			ForceNew:     true,
 		},
 		"columns": {
 			Type:     schema.TypeList,
 			Required: true,
-			ForceNew: true,
 			Elem: &schema.Schema{
 				Type:         schema.TypeString,
 				ValidateFunc: validation.NoZeroValues,
 			},
 		},
 		"cover": {
-			Type: schema.TypeList,
-			// TODO(shmel1k@): set to force new
+			Type:     schema.TypeList,
 			Optional: true,
 			Elem: &schema.Schema{
 				Type:         schema.TypeString,
 				ValidateFunc: validation.NoZeroValues,
 			},
 		},
+		"replacement_strategy": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      index.StrategyRecreate,
+			ValidateFunc: validation.StringInSlice([]string{index.StrategyRecreate, index.StrategyBuildAndSwap}, false),
+			Description:  "How changes of `type`, `columns` or `cover` are applied: `recreate` drops the index and creates it again, `build_and_swap` builds the new index under a temporary name and atomically replaces the old one once the build is finished.",
+		},
+	}
+}
+
+// ResourceCustomizeDiff forces a new index when its definition changes, unless the index is
+// replaced in place with the build_and_swap strategy. A change of the table the index belongs
+// to, e.g. when the table is renamed, moves the index in place.
+func ResourceCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	if err := helpers.PlanTableMove(d); err != nil {
+		return err
+	}
+	if d.Get("replacement_strategy").(string) == index.StrategyBuildAndSwap {
+		return nil
+	}
+	for _, k := range []string{"type", "columns", "cover"} {
+		if d.HasChange(k) {
+			if err := d.ForceNew(k); err != nil {
+				return err
+			}
+		}
 	}
+	return nil
 }
@@ -86,18 +86,28 @@ func ResourceReadFunc(cb auth.GetAuthCallback) helpers.TerraformCRUD {
 
 func ResourceSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Description: "Table resource ID. It changes when the table is renamed.",
+			Computed:    true,
+		},
 		"path": {
 			Type:         schema.TypeString,
-			Description:  "Table path.",
+			Description:  "Table path. Changing it creates a new table unless `allow_rename` is set.",
 			Required:     true,
-			ForceNew:     true,
 			ValidateFunc: helpers.YdbTablePathCheck,
 		},
+		"allow_rename": {
+			Type:        schema.TypeBool,
+			Description: "Rename the table when `path` changes instead of destroying it and creating a new one. Indexes and changefeeds are moved with the table.",
+			Optional:    true,
+		},
 		"connection_string": {
 			Type:        schema.TypeString,
 			Description: "Connection string for database.",
 			ForceNew:    true,
-			Required:    true,
+			Optional:    true,
+			Computed:    true,
 		},
 		"column": {
 			Type:        schema.TypeSet,
@@ -130,12 +140,17 @@ func ResourceSchema() map[string]*schema.Schema {
 						Optional:    true,
 						Computed:    true,
 					},
+					"default": {
+						Type:        schema.TypeString,
+						Description: "Literal default value of the column, written as plain text, e.g. `new`, `42` or `2024-01-01T00:00:00Z`. Defaults of existing columns cannot be changed.",
+						Optional:    true,
+					},
 				},
 			},
 		},
 		"family": {
 			Type:        schema.TypeList,
-			Description: "A list of column group configuration options. The `family` block may be used to group columns into [families](https://ydb.tech/en/docs/yql/reference/syntax/create_table#column-family) to set shared parameters for them.",
+			Description: "A list of column group configuration options. The `family` block may be used to group columns into [families](https://ydb.tech/en/docs/yql/reference/syntax/create_table#column-family) to set shared parameters for them. Families are added and altered in place; they cannot be dropped.",
 			Optional:    true,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
@@ -153,18 +168,23 @@ func ResourceSchema() map[string]*schema.Schema {
 					},
 					"compression": {
 						Type:         schema.TypeString,
-						Description:  "Data codec (acceptable values: off, lz4).",
+						Description:  "Data codec (acceptable values: off, lz4, and zstd for column tables).",
 						Required:     true,
-						ValidateFunc: validation.NoZeroValues,
+						ValidateFunc: validation.StringInSlice([]string{"off", "lz4", "zstd"}, true),
+					},
+					"compression_level": {
+						Type:         schema.TypeInt,
+						Description:  "Codec compression level. Supported for column tables only. Removing it keeps the current level.",
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(1),
 					},
 				},
 			},
 		},
 		"primary_key": {
 			Type:        schema.TypeList,
-			Description: "A list of table columns to be used as primary key.",
+			Description: "A list of table columns to be used as primary key. Changing it recreates the table, or migrates it with `migration_strategy = \"copy\"`.",
 			Required:    true,
-			ForceNew:    true,
 			Elem: &schema.Schema{
 				Type:         schema.TypeString,
 				ValidateFunc: validation.NoZeroValues, // TODO(shmel1k@): think about validate func
@@ -172,14 +192,21 @@ func ResourceSchema() map[string]*schema.Schema {
 		},
 		"store": {
 			Type:         schema.TypeString,
-			Description:  "Table storage type. Set to `column` for column-oriented tables. Omit for row-oriented tables (default).",
+			Description:  "Table storage type. Set to `column` for column-oriented tables. Omit for row-oriented tables (default). Changing it recreates the table, or migrates it with `migration_strategy = \"copy\"`.",
 			Optional:     true,
-			ForceNew:     true,
 			ValidateFunc: validation.StringInSlice([]string{"column"}, true),
 		},
+		"tablestore": {
+			Type:         schema.TypeString,
+			Description:  "Path of the tablestore the column table is placed in, relative to the database root. The table `path` must be inside the tablestore, and its columns and primary key must be the ones of the tablestore.",
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: helpers.YdbTablePathCheck,
+		},
 		"ttl": {
 			Type:        schema.TypeSet,
-			Description: "The `TTL` block supports allow you to create a special column type, [TTL column](https://ydb.tech/en/docs/concepts/ttl), whose values determine the time-to-live for rows.",
+			Description: "The `TTL` block supports allow you to create a special column type, [TTL column](https://ydb.tech/en/docs/concepts/ttl), whose values determine the time-to-live for rows. Rows are either deleted after `expire_interval` or go through the `tier` list.",
 			MaxItems:    1,
 			Optional:    true,
 			Elem: &schema.Resource{
@@ -192,8 +219,8 @@ func ResourceSchema() map[string]*schema.Schema {
 					},
 					"expire_interval": {
 						Type:         schema.TypeString,
-						Description:  "Interval in the ISO 8601 format.",
-						Required:     true,
+						Description:  "Interval in the ISO 8601 format after which rows are deleted. Exactly one of `expire_interval` and `tier` must be set.",
+						Optional:     true,
 						ValidateFunc: validation.NoZeroValues,
 					},
 					"unit": {
@@ -202,6 +229,27 @@ func ResourceSchema() map[string]*schema.Schema {
 						Computed:     true,
 						ValidateFunc: helpers.YdbTTLUnitCheck,
 					},
+					"tier": {
+						Type:        schema.TypeList,
+						Description: "Tiers of a tiered TTL, in the order of increasing `expire_interval`. Each tier either moves rows to an external data source or, in the last tier only, deletes them. YDB does not report eviction tiers in the table description: changes made outside Terraform are not detected and `terraform import` does not restore them.",
+						Optional:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"expire_interval": {
+									Type:         schema.TypeString,
+									Description:  "Interval in the ISO 8601 format after which the tier applies.",
+									Required:     true,
+									ValidateFunc: validation.NoZeroValues,
+								},
+								"external_data_source": {
+									Type:         schema.TypeString,
+									Description:  "Path of the `ydb_external_data_source` rows are moved to, relative to the database root. Rows are deleted when omitted.",
+									Optional:     true,
+									ValidateFunc: validation.NoZeroValues,
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -233,11 +281,27 @@ func ResourceSchema() map[string]*schema.Schema {
 							Schema: map[string]*schema.Schema{
 								"keys": {
 									Type:     schema.TypeList,
-									Required: true,
+									Optional: true,
 									Elem: &schema.Schema{
 										Type: schema.TypeString,
 									},
 								},
+								"key": {
+									Type:     schema.TypeList,
+									Optional: true,
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"value": {
+												Type:     schema.TypeString,
+												Optional: true,
+											},
+											"null": {
+												Type:     schema.TypeBool,
+												Optional: true,
+											},
+										},
+									},
+								},
 							},
 						},
 					},
@@ -272,10 +336,9 @@ func ResourceSchema() map[string]*schema.Schema {
 					},
 					"partition_by": {
 						Type:         schema.TypeList,
-						Description:  "Partitioning keys constitute a subset of the table's primary keys. If not set, primary keys will be used.",
+						Description:  "Partitioning keys constitute a subset of the table's primary keys. If not set, primary keys will be used. Changing it recreates the table, or migrates it with `migration_strategy = \"copy\"`.",
 						Optional:     true,
 						Computed:     true,
-						ForceNew:     true,
 						RequiredWith: []string{"store"},
 						Elem: &schema.Schema{
 							Type:         schema.TypeString,
@@ -292,11 +355,84 @@ func ResourceSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Computed:    true,
 		},
+		"read_replicas": {
+			Type:          schema.TypeList,
+			Description:   "Read replicas of a row table.",
+			MaxItems:      1,
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{"read_replicas_settings"},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"mode": {
+						Type:         schema.TypeString,
+						Description:  "`PER_AZ` to place `count` replicas in every availability zone, `ANY_AZ` to place `count` replicas in any availability zones.",
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{table.ReadReplicasModePerAZ, table.ReadReplicasModeAnyAZ}, false),
+					},
+					"count": {
+						Type:         schema.TypeInt,
+						Description:  "Number of read replicas.",
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+				},
+			},
+		},
 		"read_replicas_settings": {
-			Type:        schema.TypeString,
-			Description: "Read replication settings.",
+			Type:             schema.TypeString,
+			Description:      "Read replication settings in the `MODE:COUNT` form, e.g. `PER_AZ:1`.",
+			Deprecated:       "Use the read_replicas block instead.",
+			Optional:         true,
+			Computed:         true,
+			ConflictsWith:    []string{"read_replicas"},
+			ValidateFunc:     table.ValidateReadReplicasSettings,
+			DiffSuppressFunc: table.SuppressReadReplicasSettingsDiff,
+		},
+		"allow_column_drop": {
+			Type:        schema.TypeBool,
+			Description: "Allow dropping the columns removed from the `column` list with `ALTER TABLE ... DROP COLUMN`. The data of the dropped columns is destroyed. Primary key, TTL and secondary index columns cannot be dropped. Removing columns is an error when not set.",
 			Optional:    true,
+		},
+		"dropped_columns": {
+			Type:        schema.TypeList,
+			Description: "Columns dropped by the last apply. The plan shows the columns an apply is going to drop as the new value.",
 			Computed:    true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"migration_strategy": {
+			Type:         schema.TypeString,
+			Description:  "How changes of `primary_key`, `store` and `partition_by` are applied. `recreate` (default) drops the table and creates a new one. `copy` creates the new table under a temporary path, copies the rows in batches, compares the row counts and swaps the tables, keeping the old one under a backup name.",
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{table.MigrationStrategyRecreate, table.MigrationStrategyCopy}, false),
+		},
+		"migration_backup_retention": {
+			Type:             schema.TypeString,
+			Description:      "How long the backup of a table migrated with `migration_strategy = \"copy\"` is kept, e.g. `72h`. Expired backups are dropped on the next apply. Backups are kept until the resource is destroyed when not set.",
+			Optional:         true,
+			ValidateFunc:     helpers.ValidatePositiveDuration,
+			DiffSuppressFunc: helpers.SuppressDurationDiff,
+		},
+		"migration_backups": {
+			Type:        schema.TypeList,
+			Description: "Backups of the table kept after copy migrations.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"path": {
+						Type:        schema.TypeString,
+						Description: "Path of the backup table, relative to the database root.",
+						Computed:    true,
+					},
+					"expires_at": {
+						Type:        schema.TypeString,
+						Description: "Time in the RFC 3339 format after which the backup is dropped. Empty if the backup is kept until the resource is destroyed.",
+						Computed:    true,
+					},
+				},
+			},
 		},
 	}
 }
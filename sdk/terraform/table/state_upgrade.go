@@ -0,0 +1,224 @@
+package table
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/resources/table"
+)
+
+// SchemaVersion is the version of the ydb_table resource schema.
+const SchemaVersion = 1
+
+// StateUpgraders migrate the states of older ydb_table schema versions.
+func StateUpgraders() []schema.StateUpgrader {
+	return []schema.StateUpgrader{
+		{
+			Version: 0,
+			Type:    resourceSchemaV0().CoreConfigSchema().ImpliedType(),
+			Upgrade: UpgradeStateV0,
+		},
+	}
+}
+
+// resourceSchemaV0 is a frozen copy of the schema before the read_replicas block was added. It
+// must not follow ResourceSchema: the upgrader decodes the states written with this schema.
+func resourceSchemaV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"connection_string": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"column": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"family": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"not_null": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"family": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"data": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"compression": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"primary_key": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"store": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"ttl": {
+				Type:     schema.TypeSet,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"column_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"expire_interval": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"unit": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"attributes": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"partitioning_settings": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uniform_partitions": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"partition_at_keys": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"keys": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"auto_partitioning_min_partitions_count": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"auto_partitioning_max_partitions_count": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"auto_partitioning_partition_size_mb": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"auto_partitioning_by_load": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"auto_partitioning_by_size_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"partition_by": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"key_bloom_filter": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"read_replicas_settings": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// UpgradeStateV0 fills the read_replicas block from the read_replicas_settings string. The
+// string is kept: configurations using it stay supported.
+func UpgradeStateV0(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+	rawState["read_replicas"] = []interface{}{}
+	s, _ := rawState["read_replicas_settings"].(string)
+	if s == "" {
+		return rawState, nil
+	}
+	replicas, err := table.ParseReadReplicas(s)
+	if err != nil || replicas.Count == 0 {
+		// Tables without read replicas were read as PER_AZ:0.
+		rawState["read_replicas_settings"] = ""
+		return rawState, nil
+	}
+	rawState["read_replicas"] = []interface{}{
+		map[string]interface{}{
+			"mode":  replicas.Mode,
+			"count": replicas.Count,
+		},
+	}
+	return rawState, nil
+}
@@ -0,0 +1,37 @@
+package table
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpgradeStateV0(t *testing.T) {
+	got, err := UpgradeStateV0(context.Background(), map[string]interface{}{
+		"path":                   "t",
+		"read_replicas_settings": "ANY_AZ:2",
+	}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "ANY_AZ:2", got["read_replicas_settings"])
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"mode": "ANY_AZ", "count": uint64(2)},
+	}, got["read_replicas"])
+
+	got, err = UpgradeStateV0(context.Background(), map[string]interface{}{
+		"path":                   "t",
+		"read_replicas_settings": "PER_AZ:0",
+	}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "", got["read_replicas_settings"])
+	assert.Equal(t, []interface{}{}, got["read_replicas"])
+}
+
+func TestResourceSchemaV0(t *testing.T) {
+	v0 := resourceSchemaV0()
+	require.NoError(t, v0.InternalValidate(nil, true))
+	assert.NotContains(t, v0.Schema, "read_replicas")
+	assert.NotContains(t, v0.Schema, "migration_strategy", "the v0 schema is frozen, it does not follow ResourceSchema")
+	assert.Contains(t, v0.CoreConfigSchema().ImpliedType().AttributeTypes(), "read_replicas_settings")
+}
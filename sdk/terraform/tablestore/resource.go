@@ -0,0 +1,139 @@
+package tablestore
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	tablestoreHandler "github.com/ydb-platform/terraform-provider-ydb/internal/resources/tablestore"
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
+)
+
+func ResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"connection_string": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "Connection string for YDB database.",
+		},
+		"path": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			Description:  "Tablestore path relative to the database root.",
+			ValidateFunc: helpers.YdbTablePathCheck,
+		},
+		"column": {
+			Type:        schema.TypeSet,
+			Required:    true,
+			Description: "Columns shared by the tables of the tablestore. Columns can be added and dropped in place.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:         schema.TypeString,
+						Description:  "Column name.",
+						Required:     true,
+						ValidateFunc: validation.NoZeroValues,
+					},
+					"type": {
+						Type:         schema.TypeString,
+						Description:  "Column data type. YQL data types are used.",
+						Required:     true,
+						ValidateFunc: validation.NoZeroValues,
+					},
+					"not_null": {
+						Type:        schema.TypeBool,
+						Description: "A column cannot have the NULL data type. Default: `false`.",
+						Optional:    true,
+						Default:     false,
+					},
+				},
+			},
+		},
+		"primary_key": {
+			Type:        schema.TypeList,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Columns of the primary key shared by the tables of the tablestore.",
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+		"shards_count": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			Description:  "Number of column shards the data of the tablestore is partitioned into.",
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"full_path": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Absolute path of the tablestore.",
+		},
+	}
+}
+
+// ResourceCustomizeDiff rejects column changes YDB cannot apply to a tablestore at plan time.
+func ResourceCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	return tablestoreHandler.ValidateResourceDiff(d)
+}
+
+func ResourceCreateFunc(cb auth.GetAuthCallback) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		authCreds, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{Severity: diag.Error, Summary: "failed to create token for YDB request", Detail: err.Error()},
+			}
+		}
+		h := tablestoreHandler.NewHandler(authCreds)
+		return h.Create(ctx, d, meta)
+	}
+}
+
+func ResourceReadFunc(cb auth.GetAuthCallback) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		authCreds, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{Severity: diag.Error, Summary: "failed to create token for YDB request", Detail: err.Error()},
+			}
+		}
+		h := tablestoreHandler.NewHandler(authCreds)
+		return h.Read(ctx, d, meta)
+	}
+}
+
+func ResourceUpdateFunc(cb auth.GetAuthCallback) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		authCreds, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{Severity: diag.Error, Summary: "failed to create token for YDB request", Detail: err.Error()},
+			}
+		}
+		h := tablestoreHandler.NewHandler(authCreds)
+		return h.Update(ctx, d, meta)
+	}
+}
+
+func ResourceDeleteFunc(cb auth.GetAuthCallback) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		authCreds, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{Severity: diag.Error, Summary: "failed to create token for YDB request", Detail: err.Error()},
+			}
+		}
+		h := tablestoreHandler.NewHandler(authCreds)
+		return h.Delete(ctx, d, meta)
+	}
+}
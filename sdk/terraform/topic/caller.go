@@ -8,12 +8,12 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	ydb "github.com/ydb-platform/ydb-go-sdk/v3"
 	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topicoptions"
 	"github.com/ydb-platform/ydb-go-sdk/v3/topic/topictypes"
 
 	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
 	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers/topic"
+	tbl "github.com/ydb-platform/terraform-provider-ydb/internal/table"
 	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/attributes"
 	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
 )
@@ -32,9 +32,7 @@ func (c *caller) createYDBConnection(
 	ctx context.Context,
 	d helpers.ResourceDataProxy,
 	ydbEn *helpers.YDBEntity,
-) (*ydb.Driver, error) {
-	// TODO(shmel1k@): move to other level.
-	var opts []ydb.Option
+) (*tbl.Driver, error) {
 	var databaseEndpoint string
 	if ydbEn != nil {
 		databaseEndpoint = ydbEn.PrepareFullYDBEndpoint()
@@ -43,14 +41,10 @@ func (c *caller) createYDBConnection(
 		databaseEndpoint = d.Get(attributes.DatabaseEndpoint).(string)
 	}
 
-	switch {
-	case c.authCreds.Token != "":
-		opts = append(opts, ydb.WithAccessTokenCredentials(c.authCreds.Token))
-	case c.authCreds.User != "":
-		opts = append(opts, ydb.WithStaticCredentials(c.authCreds.User, c.authCreds.Password))
-	}
-
-	sess, err := ydb.Open(ctx, databaseEndpoint, opts...)
+	sess, err := tbl.CreateDBConnection(ctx, tbl.ClientParams{
+		DatabaseEndpoint: databaseEndpoint,
+		AuthCreds:        c.authCreds,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create control-plane client: %w", err)
 	}
@@ -86,7 +80,9 @@ func (c *caller) performYDBTopicUpdate(ctx context.Context, d *schema.ResourceDa
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	err = topicClient.Alter(ctx, topicName, opts...)
+	err = ydbClient.Retry(ctx, "alter topic", tbl.NonIdempotent, func(ctx context.Context) error {
+		return topicClient.Alter(ctx, topicName, opts...)
+	})
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("got error when tried to alter topic: %w", err))
 	}
@@ -251,7 +247,9 @@ func (c *caller) resourceYDBTopicCreate(ctx context.Context, d *schema.ResourceD
 		options = append(options, topicoptions.CreateWithPartitionWriteBurstBytes(int64(writeSpeed)))
 		options = append(options, topicoptions.CreateWithPartitionWriteSpeedBytesPerSecond(int64(writeSpeed)))
 	}
-	err = client.Topic().Create(ctx, d.Get(attributeName).(string), options...)
+	err = client.Retry(ctx, "create topic", tbl.NonIdempotent, func(ctx context.Context) error {
+		return client.Topic().Create(ctx, d.Get(attributeName).(string), options...)
+	})
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to initialize ydb-topic control plane client: %w", err))
 	}
@@ -283,6 +281,8 @@ func (c *caller) resourceYDBTopicDelete(ctx context.Context, d *schema.ResourceD
 	}()
 
 	topicName := topic.GetEntityPath()
-	err = client.Topic().Drop(ctx, topicName)
+	err = client.Retry(ctx, "drop topic", tbl.NonIdempotent, func(ctx context.Context) error {
+		return client.Topic().Drop(ctx, topicName)
+	})
 	return diag.FromErr(err)
 }
@@ -0,0 +1,152 @@
+package transfer
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+	transferHandler "github.com/ydb-platform/terraform-provider-ydb/internal/resources/transfer"
+	"github.com/ydb-platform/terraform-provider-ydb/sdk/terraform/auth"
+)
+
+func ResourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"connection_string": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "Connection string of the database the transfer and the target table belong to.",
+		},
+		"path": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			Description:  "Transfer path relative to the database root.",
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		},
+		"source_topic": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			Description:  "Path of the topic messages are read from, in the source database if `source` is set.",
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		},
+		"target_table": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			Description:  "Path of the table rows are written to.",
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		},
+		"transformation_lambda": {
+			Type:             schema.TypeString,
+			Required:         true,
+			Description:      "YQL lambda converting a topic message into a list of table rows, e.g. `($msg) -> { return [<| offset: $msg._offset |>]; }`. Changes are applied in place.",
+			ValidateFunc:     validation.StringIsNotWhiteSpace,
+			DiffSuppressFunc: transferHandler.SuppressLambdaDiff,
+		},
+		"consumer": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "Topic consumer used to read messages. If not set, YDB creates a consumer for the transfer.",
+		},
+		"batch_size_bytes": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Size of the data accumulated before it is written to the table.",
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"flush_interval": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			Description:      "Maximum time data is accumulated before it is written to the table, a Go duration (e.g. `60s`).",
+			ValidateFunc:     helpers.ValidatePositiveDuration,
+			DiffSuppressFunc: helpers.SuppressDurationDiff,
+		},
+		"source": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "Connection to a remote database holding the topic. If not set, the topic is read from the database of the transfer.",
+			Elem:        helpers.ReplicationSourceResource(),
+		},
+		"state": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      transferHandler.StateRunning,
+			Description:  "Desired state: `RUNNING` or `PAUSED`.",
+			ValidateFunc: validation.StringInSlice([]string{transferHandler.StateRunning, transferHandler.StatePaused}, false),
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Observed state of the transfer: `running`, `error`, `done` or `paused`.",
+		},
+		"errors": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Issues of a transfer in the `error` state.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+func ResourceCreateFunc(cb auth.GetAuthCallback) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		authCreds, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{Severity: diag.Error, Summary: "failed to create token for YDB request", Detail: err.Error()},
+			}
+		}
+		h := transferHandler.NewHandler(authCreds)
+		return h.Create(ctx, d, meta)
+	}
+}
+
+func ResourceReadFunc(cb auth.GetAuthCallback) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		authCreds, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{Severity: diag.Error, Summary: "failed to create token for YDB request", Detail: err.Error()},
+			}
+		}
+		h := transferHandler.NewHandler(authCreds)
+		return h.Read(ctx, d, meta)
+	}
+}
+
+func ResourceUpdateFunc(cb auth.GetAuthCallback) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		authCreds, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{Severity: diag.Error, Summary: "failed to create token for YDB request", Detail: err.Error()},
+			}
+		}
+		h := transferHandler.NewHandler(authCreds)
+		return h.Update(ctx, d, meta)
+	}
+}
+
+func ResourceDeleteFunc(cb auth.GetAuthCallback) helpers.TerraformCRUD {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		authCreds, err := cb(ctx)
+		if err != nil {
+			return diag.Diagnostics{
+				{Severity: diag.Error, Summary: "failed to create token for YDB request", Detail: err.Error()},
+			}
+		}
+		h := transferHandler.NewHandler(authCreds)
+		return h.Delete(ctx, d, meta)
+	}
+}
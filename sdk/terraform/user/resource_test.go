@@ -0,0 +1,28 @@
+package user
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ydb-platform/terraform-provider-ydb/internal/helpers"
+)
+
+func TestResourceSchemaHashesPasswords(t *testing.T) {
+	res := &schema.Resource{Schema: ResourceSchema()}
+	for _, key := range []string{"password", "password_hash"} {
+		secret := `{"hash":"p4ss","salt":"s4lt","type":"argon2id"}`
+		diff, err := res.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+			"connection_string": "grpc://localhost:2136/?database=/local",
+			"name":              "app",
+			key:                 secret,
+		}), nil)
+		require.NoError(t, err)
+		assert.Equal(t, helpers.HashSensitiveValue(secret), diff.Attributes[key].New, "%s is stored hashed", key)
+		assert.NotContains(t, diff.Attributes[key].New, "p4ss")
+	}
+}